@@ -35,6 +35,35 @@ func (m *PipelinesChecksClientExtrasV5) EXPECT() *PipelinesChecksClientExtrasV5M
 	return m.recorder
 }
 
+// AddCheckConfiguration mocks base method.
+func (m *PipelinesChecksClientExtrasV5) AddCheckConfiguration(arg0 context.Context, arg1 pipelineschecks.AddCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelineschecks.CheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCheckConfiguration indicates an expected call of AddCheckConfiguration.
+func (mr *PipelinesChecksClientExtrasV5MockRecorder) AddCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCheckConfiguration", reflect.TypeOf((*PipelinesChecksClientExtrasV5)(nil).AddCheckConfiguration), arg0, arg1)
+}
+
+// DeleteCheckConfiguration mocks base method.
+func (m *PipelinesChecksClientExtrasV5) DeleteCheckConfiguration(arg0 context.Context, arg1 pipelineschecks.DeleteCheckConfigurationArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckConfiguration indicates an expected call of DeleteCheckConfiguration.
+func (mr *PipelinesChecksClientExtrasV5MockRecorder) DeleteCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckConfiguration", reflect.TypeOf((*PipelinesChecksClientExtrasV5)(nil).DeleteCheckConfiguration), arg0, arg1)
+}
+
 // GetCheckConfiguration mocks base method.
 func (m *PipelinesChecksClientExtrasV5) GetCheckConfiguration(arg0 context.Context, arg1 pipelineschecks.GetCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -49,3 +78,18 @@ func (mr *PipelinesChecksClientExtrasV5MockRecorder) GetCheckConfiguration(arg0,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckConfiguration", reflect.TypeOf((*PipelinesChecksClientExtrasV5)(nil).GetCheckConfiguration), arg0, arg1)
 }
+
+// UpdateCheckConfiguration mocks base method.
+func (m *PipelinesChecksClientExtrasV5) UpdateCheckConfiguration(arg0 context.Context, arg1 pipelineschecks.UpdateCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelineschecks.CheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCheckConfiguration indicates an expected call of UpdateCheckConfiguration.
+func (mr *PipelinesChecksClientExtrasV5MockRecorder) UpdateCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckConfiguration", reflect.TypeOf((*PipelinesChecksClientExtrasV5)(nil).UpdateCheckConfiguration), arg0, arg1)
+}
@@ -0,0 +1,35 @@
+//go:build (all || data_sources || data_agent_queues) && (!exclude_data_sources || !exclude_data_agent_queues)
+// +build all data_sources data_agent_queues
+// +build !exclude_data_sources !exclude_data_agent_queues
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAgentQueues_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	agentQueuesData := testutils.HclAgentQueuesDataSource(projectName)
+
+	tfNode := "data.azuredevops_agent_queues.queues"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: agentQueuesData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "agent_queues.0.id"),
+					resource.TestCheckResourceAttrSet(tfNode, "agent_queues.0.name"),
+					resource.TestCheckResourceAttrSet(tfNode, "agent_queues.0.agent_pool_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "agent_queues.0.pool_type"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,41 @@
+//go:build (all || data_sources || data_agents) && (!exclude_data_sources || !exclude_data_agents)
+// +build all data_sources data_agents
+// +build !exclude_data_sources !exclude_data_agents
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccAgents_DataSource(t *testing.T) {
+	poolName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_agents.agents"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "azuredevops_agent_pool" "pool" {
+  name           = "%s"
+  auto_provision = false
+}
+
+data "azuredevops_agents" "agents" {
+  pool_id = azuredevops_agent_pool.pool.id
+  status  = "offline"
+}`, poolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "agents.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,39 @@
+//go:build (all || data_sources || data_environment_resources_virtual_machine) && (!exclude_data_sources || !exclude_data_environment_resources_virtual_machine)
+// +build all data_sources data_environment_resources_virtual_machine
+// +build !exclude_data_sources !exclude_data_environment_resources_virtual_machine
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccEnvironmentResourcesVirtualMachine_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_environment_resources_virtual_machine.vms"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_environment_resources_virtual_machine" "vms" {
+  project_id     = azuredevops_project.project.id
+  environment_id = azuredevops_environment.environment.id
+}`, testutils.HclEnvironmentResource(projectName, environmentName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "virtual_machines.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,44 @@
+//go:build (all || data_sources || git || data_git_repository_file) && (!exclude_data_sources || !exclude_git || !exclude_data_git_repository_file)
+// +build all data_sources git data_git_repository_file
+// +build !exclude_data_sources !exclude_git !exclude_data_git_repository_file
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// Verifies that the content of a file committed to a branch can be read
+func TestAccGitRepositoryFile_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_git_repository_file.file"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_git_repository_file" "file" {
+  repository_id = azuredevops_git_repository.repository.id
+  file          = azuredevops_git_repository_file.file.file
+  branch        = azuredevops_git_repository_file.file.branch
+}`, testutils.HclGitRepoFileResource(projectName, gitRepoName, "Clean", "refs/heads/master", "foo.txt", "bar")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "content", "bar"),
+					resource.TestCheckResourceAttr(tfNode, "content_base64", "YmFy"),
+					resource.TestCheckResourceAttr(tfNode, "is_binary", "false"),
+					resource.TestCheckResourceAttrSet(tfNode, "object_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "commit_id"),
+				),
+			},
+		},
+	})
+}
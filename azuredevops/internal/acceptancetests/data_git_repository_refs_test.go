@@ -0,0 +1,59 @@
+//go:build (all || data_sources || git || data_git_repository_refs) && (!exclude_data_sources || !exclude_git || !exclude_data_git_repository_refs)
+// +build all data_sources git data_git_repository_refs
+// +build !exclude_data_sources !exclude_git !exclude_data_git_repository_refs
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// Verifies that the branches and tags matching the filter are listed
+func TestAccGitRepositoryRefs_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_git_repository_refs.refs"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository_branch" "release" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "release/1.0"
+  ref_branch    = azuredevops_git_repository.repository.default_branch
+}
+
+resource "azuredevops_git_repository_tag" "release" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "release/1.0.0"
+  ref_branch    = azuredevops_git_repository.repository.default_branch
+}
+
+data "azuredevops_git_repository_refs" "refs" {
+  repository_id = azuredevops_git_repository.repository.id
+  filter        = "release/"
+  depends_on    = [azuredevops_git_repository_branch.release, azuredevops_git_repository_tag.release]
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "branches.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "branches.0.name", "release/1.0"),
+					resource.TestCheckResourceAttr(tfNode, "branches.0.ahead_count", "0"),
+					resource.TestCheckResourceAttr(tfNode, "branches.0.behind_count", "0"),
+					resource.TestCheckResourceAttrSet(tfNode, "branches.0.commit_id"),
+					resource.TestCheckResourceAttr(tfNode, "tags.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "tags.0.name", "release/1.0.0"),
+					resource.TestCheckResourceAttrPair(tfNode, "tags.0.commit_id", tfNode, "branches.0.commit_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,43 @@
+//go:build (all || data_sources || data_variable_groups) && (!exclude_data_sources || !exclude_data_variable_groups)
+// +build all data_sources data_variable_groups
+// +build !exclude_data_sources !exclude_data_variable_groups
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccVariableGroups_DataSource(t *testing.T) {
+	variableGroupName := testutils.GenerateResourceName()
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_variable_groups" "vgs" {
+  project_id       = azuredevops_project.project.id
+  name_pattern     = azuredevops_variable_group.vg.name
+  key_vault_linked = false
+}`, testutils.HclVariableGroupResourceWithProject(testutils.GenerateResourceName(), variableGroupName, true))
+
+	tfNode := "data.azuredevops_variable_groups.vgs"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "variable_groups.#", "1"),
+					resource.TestCheckResourceAttrPair(tfNode, "variable_groups.0.id", "azuredevops_variable_group.vg", "id"),
+					resource.TestCheckResourceAttr(tfNode, "variable_groups.0.name", variableGroupName),
+					resource.TestCheckResourceAttr(tfNode, "variable_groups.0.allow_access", "true"),
+					resource.TestCheckResourceAttr(tfNode, "variable_groups.0.variable.#", "3"),
+				),
+			},
+		},
+	})
+}
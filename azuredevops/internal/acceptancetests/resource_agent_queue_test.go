@@ -5,6 +5,7 @@
 package acceptancetests
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -36,3 +37,47 @@ func TestAccResourceAgentQueue_CreateAndUpdate(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceAgentQueue_AuthorizeAllPipelines(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	poolName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_agent_queue.q"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAgentQueueAuthorizeAllPipelines(projectName, poolName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "authorize_all_pipelines", "true"),
+				),
+			}, {
+				Config: hclAgentQueueAuthorizeAllPipelines(projectName, poolName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "authorize_all_pipelines", "false"),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclAgentQueueAuthorizeAllPipelines(projectName, poolName string, authorized bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_project" "p" {
+	name = "%s"
+}
+
+resource "azuredevops_agent_queue" "q" {
+	project_id              = azuredevops_project.p.id
+	agent_pool_id           = azuredevops_agent_pool.pool.id
+	authorize_all_pipelines = %t
+}`, testutils.HclAgentPoolResource(poolName), projectName, authorized)
+}
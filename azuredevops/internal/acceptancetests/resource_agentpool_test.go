@@ -85,6 +85,39 @@ func TestAccAgentPool_update(t *testing.T) {
 	})
 }
 
+func TestAccAgentPool_maintenance(t *testing.T) {
+	poolName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_agent_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkAgentPoolDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclAgentPoolMaintenance(poolName, 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "maintenance.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "maintenance.0.working_directory_expiration_in_days", "30"),
+					resource.TestCheckResourceAttr(tfNode, "maintenance.0.schedule.0.days.#", "2"),
+				),
+			},
+			{
+				Config: hclAgentPoolMaintenance(poolName, 7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "maintenance.0.working_directory_expiration_in_days", "7"),
+				),
+			},
+			{
+				Config: hclAgentPoolBasic(poolName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "maintenance.#", "0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAgentPool_requiresImportErrorStep(t *testing.T) {
 	poolName := testutils.GenerateResourceName()
 	tfNode := "azuredevops_agent_pool.test"
@@ -160,6 +193,29 @@ resource "azuredevops_agent_pool" "test" {
 }`, name)
 }
 
+func hclAgentPoolMaintenance(name string, expirationInDays int) string {
+	return fmt.Sprintf(`
+resource "azuredevops_agent_pool" "test" {
+  name           = "%s"
+  auto_provision = false
+  auto_update    = false
+  pool_type      = "automation"
+
+  maintenance {
+    enabled                              = true
+    working_directory_expiration_in_days = %d
+    records_to_keep                      = 5
+
+    schedule {
+      days          = ["Mon", "Sat"]
+      start_hours   = 3
+      start_minutes = 30
+      time_zone     = "(UTC+01:00) Amsterdam, Berlin, Bern, Rome, Stockholm, Vienna"
+    }
+  }
+}`, name, expirationInDays)
+}
+
 func hclAgentPoolResourceRequiresImport(name string) string {
 	return fmt.Sprintf(`
 %s
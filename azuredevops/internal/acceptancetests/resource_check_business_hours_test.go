@@ -155,6 +155,34 @@ func TestAccCheckBusinessHours_update(t *testing.T) {
 	})
 }
 
+func TestAccCheckBusinessHours_agentPool(t *testing.T) {
+	poolName := testutils.GenerateResourceName()
+	checkName := testutils.GenerateResourceName()
+	start_time := "01:20"
+	end_time := "03:20"
+
+	resourceType := "azuredevops_check_business_hours"
+	tfCheckNode := resourceType + ".test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckPipelineCheckDestroyed(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: hclCheckBusinessHoursResourceAgentPool(poolName, checkName, start_time, end_time),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckPipelineCheckExistsWithName(tfCheckNode, checkName),
+					resource.TestCheckResourceAttr(tfCheckNode, "project_id", ""),
+					resource.TestCheckResourceAttrSet(tfCheckNode, "target_resource_id"),
+					resource.TestCheckResourceAttr(tfCheckNode, "target_resource_type", "agentpool"),
+					resource.TestCheckResourceAttr(tfCheckNode, "start_time", start_time),
+					resource.TestCheckResourceAttr(tfCheckNode, "end_time", end_time),
+				),
+			},
+		},
+	})
+}
+
 func hclCheckBusinessHoursResourceBasic(projectName string, checkName string, start_time string, end_time string) string {
 	checkResource := fmt.Sprintf(`
 resource "azuredevops_check_business_hours" "test" {
@@ -221,3 +249,19 @@ resource "azuredevops_check_business_hours" "test" {
 	genericServiceEndpointResource := testutils.HclServiceEndpointGenericResource(projectName, "serviceendpoint", "https://test/", "test", "test")
 	return fmt.Sprintf("%s\n%s", genericServiceEndpointResource, checkResource)
 }
+
+func hclCheckBusinessHoursResourceAgentPool(poolName string, checkName string, start_time string, end_time string) string {
+	checkResource := fmt.Sprintf(`
+resource "azuredevops_check_business_hours" "test" {
+  display_name         = "%s"
+  target_resource_id   = azuredevops_agent_pool.pool.id
+  target_resource_type = "agentpool"
+  time_zone            = "UTC"
+  start_time           = "%s"
+  end_time             = "%s"
+  monday               = true
+}`, checkName, start_time, end_time)
+
+	agentPoolResource := testutils.HclAgentPoolResource(poolName)
+	return fmt.Sprintf("%s\n%s", agentPoolResource, checkResource)
+}
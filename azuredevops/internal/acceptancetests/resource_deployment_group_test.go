@@ -0,0 +1,108 @@
+//go:build (all || resource_deployment_group) && !exclude_resource_deployment_group
+// +build all resource_deployment_group
+// +build !exclude_resource_deployment_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccDeploymentGroup_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	poolName := testutils.GenerateResourceName()
+	groupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_deployment_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkDeploymentGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclDeploymentGroup(projectName, poolName, groupName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", groupName),
+					resource.TestCheckResourceAttr(tfNode, "description", "first"),
+					resource.TestCheckResourceAttrPair(tfNode, "pool_id", "azuredevops_agent_pool.pool", "id"),
+					resource.TestCheckResourceAttr(tfNode, "machine_count", "0"),
+				),
+			},
+			{
+				Config: hclDeploymentGroup(projectName, poolName, groupName+"-renamed", "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", groupName+"-renamed"),
+					resource.TestCheckResourceAttr(tfNode, "description", "second"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_deployment_group_targets" "targets" {
+  project_id          = azuredevops_project.project.id
+  deployment_group_id = azuredevops_deployment_group.test.id
+}`, hclDeploymentGroup(projectName, poolName, groupName+"-renamed", "second")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azuredevops_deployment_group_targets.targets", "targets.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func checkDeploymentGroupDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_deployment_group" {
+			continue
+		}
+
+		id, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Deployment group ID=%s cannot be parsed!. Error=%v", res.Primary.ID, err)
+		}
+
+		if _, err := clients.TaskAgentClient.GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+			Project:           converter.String(res.Primary.Attributes["project_id"]),
+			DeploymentGroupId: &id,
+		}); err == nil {
+			return fmt.Errorf("Deployment group with ID %d should not exist", id)
+		}
+	}
+
+	return nil
+}
+
+func hclDeploymentGroup(projectName string, poolName string, groupName string, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_agent_pool" "pool" {
+  name      = "%s"
+  pool_type = "deployment"
+}
+
+resource "azuredevops_deployment_group" "test" {
+  project_id  = azuredevops_project.project.id
+  name        = "%s"
+  description = "%s"
+  pool_id     = azuredevops_agent_pool.pool.id
+}`, testutils.HclProjectResource(projectName), poolName, groupName, description)
+}
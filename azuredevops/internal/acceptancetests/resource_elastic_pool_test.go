@@ -0,0 +1,102 @@
+//go:build (all || resource_elastic_pool) && !exclude_resource_elastic_pool
+// +build all resource_elastic_pool
+// +build !exclude_resource_elastic_pool
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+func TestAccElasticPool_CreateAndUpdate(t *testing.T) {
+	t.Skip("Skipping test TestAccElasticPool_CreateAndUpdate: requires an Azure virtual machine scale set")
+	poolName := testutils.GenerateResourceName()
+	projectName := testutils.GenerateResourceName()
+	azureResourceID := os.Getenv("AZDO_TEST_VMSS_RESOURCE_ID")
+	tfNode := "azuredevops_elastic_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkElasticPoolDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclElasticPool(projectName, poolName, azureResourceID, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", poolName),
+					resource.TestCheckResourceAttr(tfNode, "desired_idle", "1"),
+					resource.TestCheckResourceAttr(tfNode, "max_capacity", "2"),
+				),
+			},
+			{
+				Config: hclElasticPool(projectName, poolName+"-renamed", azureResourceID, 0, 4),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", poolName+"-renamed"),
+					resource.TestCheckResourceAttr(tfNode, "desired_idle", "0"),
+					resource.TestCheckResourceAttr(tfNode, "max_capacity", "4"),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"authorize_all_pipelines", "project_id"},
+			},
+		},
+	})
+}
+
+func checkElasticPoolDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, resource := range s.RootModule().Resources {
+		if resource.Type != "azuredevops_elastic_pool" {
+			continue
+		}
+
+		id, err := strconv.Atoi(resource.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Elastic Pool ID=%s cannot be parsed!. Error=%v", resource.Primary.ID, err)
+		}
+
+		if _, err := clients.TaskAgentClient.GetAgentPool(clients.Ctx, taskagent.GetAgentPoolArgs{PoolId: &id}); err == nil {
+			return fmt.Errorf("Elastic Pool ID %d should not exist", id)
+		}
+	}
+
+	return nil
+}
+
+func hclElasticPool(projectName string, poolName string, azureResourceID string, desiredIdle int, maxCapacity int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_serviceendpoint_azurerm" "test" {
+  project_id                = azuredevops_project.project.id
+  service_endpoint_name     = "elastic-pool-endpoint"
+  azurerm_spn_tenantid      = "%s"
+  azurerm_subscription_id   = "%s"
+  azurerm_subscription_name = "Elastic Pool Subscription"
+}
+
+resource "azuredevops_elastic_pool" "test" {
+  name                   = "%s"
+  service_endpoint_id    = azuredevops_serviceendpoint_azurerm.test.id
+  service_endpoint_scope = azuredevops_project.project.id
+  azure_resource_id      = "%s"
+  desired_idle           = %d
+  max_capacity           = %d
+  recycle_after_each_use = false
+  time_to_live_minutes   = 30
+}`, testutils.HclProjectResource(projectName), os.Getenv("ARM_TENANT_ID"), os.Getenv("ARM_SUBSCRIPTION_ID"),
+		poolName, azureResourceID, desiredIdle, maxCapacity)
+}
@@ -0,0 +1,121 @@
+//go:build (all || permissions || resource_environment_permissions) && (!exclude_permissions || !exclude_resource_environment_permissions)
+// +build all permissions resource_environment_permissions
+// +build !exclude_permissions !exclude_resource_environment_permissions
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/datahelper"
+)
+
+func hclEnvironmentPermissions(projectName string, environmentName string, environmentScoped bool, permissions map[string]string) string {
+	environmentPermissions := datahelper.JoinMap(permissions, "=", "\n")
+	environmentID := ""
+	if environmentScoped {
+		environmentID = "environment_id = azuredevops_environment.environment.id"
+	}
+
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_group" "tf-project-readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_environment_permissions" "permissions" {
+	project_id  = azuredevops_project.project.id
+	%s
+	principal   = data.azuredevops_group.tf-project-readers.id
+	permissions = {
+		%s
+	}
+}
+`,
+		testutils.HclEnvironmentResource(projectName, environmentName),
+		environmentID,
+		environmentPermissions,
+	)
+}
+
+func TestAccEnvironmentPermissions_SetProjectPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	config := hclEnvironmentPermissions(projectName, environmentName, false, map[string]string{
+		"View":   "allow",
+		"Manage": "deny",
+		"Create": "notset",
+	})
+	tfNode := "azuredevops_environment_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckNoResourceAttr(tfNode, "environment_id"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Manage", "deny"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Create", "notset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEnvironmentPermissions_UpdateEnvironmentPermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	config1 := hclEnvironmentPermissions(projectName, environmentName, true, map[string]string{
+		"View":       "allow",
+		"Use":        "allow",
+		"Administer": "allow",
+	})
+	config2 := hclEnvironmentPermissions(projectName, environmentName, true, map[string]string{
+		"View":       "allow",
+		"Use":        "notset",
+		"Administer": "deny",
+	})
+	tfNode := "azuredevops_environment_permissions.permissions"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "environment_id"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Use", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Administer", "allow"),
+				),
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "environment_id"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.%", "3"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.View", "allow"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Use", "notset"),
+					resource.TestCheckResourceAttr(tfNode, "permissions.Administer", "deny"),
+				),
+			},
+		},
+	})
+}
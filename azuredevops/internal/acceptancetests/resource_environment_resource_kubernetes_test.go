@@ -0,0 +1,110 @@
+//go:build (all || resource_environment_resource_kubernetes) && !exclude_resource_environment_resource_kubernetes
+// +build all resource_environment_resource_kubernetes
+// +build !exclude_resource_environment_resource_kubernetes
+
+package acceptancetests
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccEnvironmentResourceKubernetes_CreateAndImport(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	serviceEndpointName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_environment_resource_kubernetes.kubernetes"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkEnvironmentResourceKubernetesDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclEnvironmentResourceKubernetes(projectName, environmentName, serviceEndpointName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", "app-namespace"),
+					resource.TestCheckResourceAttr(tfNode, "namespace", "app"),
+					resource.TestCheckResourceAttr(tfNode, "cluster_name", "sample-aks"),
+					resource.TestCheckResourceAttr(tfNode, "tags.#", "2"),
+					resource.TestCheckResourceAttrSet(tfNode, "environment_id"),
+				),
+			},
+			{
+				ResourceName: tfNode,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					res, ok := s.RootModule().Resources[tfNode]
+					if !ok {
+						return "", fmt.Errorf("Did not find a kubernetes resource in the TF state")
+					}
+					return fmt.Sprintf("%s/%s/%s", res.Primary.Attributes["project_id"], res.Primary.Attributes["environment_id"], res.Primary.ID), nil
+				},
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func checkEnvironmentResourceKubernetesDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_environment_resource_kubernetes" {
+			continue
+		}
+
+		resourceID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Kubernetes resource ID=%s cannot be parsed!. Error=%v", res.Primary.ID, err)
+		}
+		environmentID, err := strconv.Atoi(res.Primary.Attributes["environment_id"])
+		if err != nil {
+			return fmt.Errorf("Environment ID=%s cannot be parsed!. Error=%v", res.Primary.Attributes["environment_id"], err)
+		}
+
+		if _, err := clients.TaskAgentClient.GetKubernetesResource(clients.Ctx, taskagent.GetKubernetesResourceArgs{
+			Project:       converter.String(res.Primary.Attributes["project_id"]),
+			EnvironmentId: &environmentID,
+			ResourceId:    &resourceID,
+		}); err == nil {
+			return fmt.Errorf("Kubernetes resource with ID %d should not exist", resourceID)
+		}
+	}
+
+	return nil
+}
+
+func hclEnvironmentResourceKubernetes(projectName string, environmentName string, serviceEndpointName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_serviceendpoint_kubernetes" "serviceendpoint" {
+  project_id            = azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  apiserver_url         = "https://sample-kubernetes-cluster.hcp.westeurope.azmk8s.io"
+  authorization_type    = "ServiceAccount"
+  service_account {
+    token   = "kubernetes_TEST_api_token"
+    ca_cert = "kubernetes_TEST_ca_cert"
+  }
+}
+
+resource "azuredevops_environment_resource_kubernetes" "kubernetes" {
+  project_id          = azuredevops_project.project.id
+  environment_id      = azuredevops_environment.environment.id
+  service_endpoint_id = azuredevops_serviceendpoint_kubernetes.serviceendpoint.id
+  name                = "app-namespace"
+  cluster_name        = "sample-aks"
+  namespace           = "app"
+  tags                = ["web", "app"]
+}`, testutils.HclEnvironmentResource(projectName, environmentName), serviceEndpointName)
+}
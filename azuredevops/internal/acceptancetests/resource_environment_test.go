@@ -66,6 +66,56 @@ func TestAccEnvironment_CreateAndUpdate(t *testing.T) {
 	})
 }
 
+// Verifies that checks and pipeline permissions can be managed together with an environment
+func TestAccEnvironment_ChecksAndPipelinePermissions(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_environment.environment"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkEnvironmentDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclEnvironmentWithChecks(projectName, environmentName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", environmentName),
+					resource.TestCheckResourceAttr(tfNode, "exclusive_lock_check.#", "1"),
+					resource.TestCheckResourceAttrSet(tfNode, "exclusive_lock_check.0.id"),
+					resource.TestCheckResourceAttr(tfNode, "pipeline_permissions.0.authorize_all_pipelines", "true"),
+					checkEnvironmentExists(environmentName),
+				),
+			},
+			{
+				Config: hclEnvironmentWithChecks(projectName, environmentName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "exclusive_lock_check.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "pipeline_permissions.0.authorize_all_pipelines", "false"),
+					checkEnvironmentExists(environmentName),
+				),
+			},
+		},
+	})
+}
+
+func hclEnvironmentWithChecks(projectName string, environmentName string, authorizeAllPipelines bool) string {
+	projectResource := testutils.HclProjectResource(projectName)
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_environment" "environment" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+
+  exclusive_lock_check {}
+
+  pipeline_permissions {
+    authorize_all_pipelines = %t
+  }
+}`, projectResource, environmentName, authorizeAllPipelines)
+}
+
 // Given the name of an environment, this will return a function that will check whether
 // or not the environment (1) exists in the state and (2) exist in AzDO and (3) has the correct name
 func checkEnvironmentExists(expectedName string) resource.TestCheckFunc {
@@ -0,0 +1,86 @@
+//go:build (all || core || resource_git_fork_sync) && !exclude_resource_git_fork_sync
+// +build all core resource_git_fork_sync
+// +build !exclude_resource_git_fork_sync
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// Verifies that a fork of the default branch can be created in another project
+// and that the fork can be synced with a new branch of its parent
+func TestAccGitForkSync_SyncsBranchFromParent(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	forkProjectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	gitForkName := testutils.GenerateResourceName()
+	tfForkNode := "azuredevops_git_repository.fork"
+	tfSyncNode := "azuredevops_git_fork_sync.sync"
+
+	hclFork := fmt.Sprintf(`
+%s
+
+resource "azuredevops_project" "fork" {
+  name               = "%s"
+  visibility         = "private"
+  version_control    = "Git"
+  work_item_template = "Agile"
+}
+
+resource "azuredevops_git_repository" "fork" {
+  project_id           = azuredevops_project.fork.id
+  name                 = "%s"
+  parent_repository_id = azuredevops_git_repository.repository.id
+  include_statistics   = true
+  fork_options {
+    include_all_refs = false
+  }
+  initialization {
+    init_type = "Uninitialized"
+  }
+}
+
+resource "azuredevops_git_repository_branch" "feature" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "feature"
+  ref_branch    = azuredevops_git_repository.repository.default_branch
+  depends_on    = [azuredevops_git_repository.fork]
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"), forkProjectName, gitForkName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclFork,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfForkNode, "name", gitForkName),
+					resource.TestCheckResourceAttr(tfForkNode, "is_fork", "true"),
+					resource.TestCheckResourceAttr(tfForkNode, "branch_count", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_fork_sync" "sync" {
+  repository_id = azuredevops_git_repository.fork.id
+  ref_mapping {
+    source_ref = azuredevops_git_repository_branch.feature.name
+    target_ref = "upstream/feature"
+  }
+}`, hclFork),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfSyncNode, "status", "completed"),
+					resource.TestCheckResourceAttrPair(tfSyncNode, "source_repository_id", "azuredevops_git_repository.repository", "id"),
+					resource.TestCheckResourceAttrSet(tfSyncNode, "operation_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,76 @@
+//go:build (all || core || resource_git_repository_directory) && !exclude_resource_git_repository_directory
+// +build all core resource_git_repository_directory
+// +build !exclude_resource_git_repository_directory
+
+package acceptancetests
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// TestAccGitRepoDirectory_CreateAndUpdate verifies that a local directory is mirrored into the
+// repository and that files which are not part of the directory are reported as untracked
+func TestAccGitRepoDirectory_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_repository_directory.directory"
+
+	sourceDirectory := t.TempDir()
+	writeFile := func(name string, content string) func() {
+		return func() {
+			filePath := filepath.Join(sourceDirectory, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(filePath, []byte(content), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeFile("index.html", "<html></html>")()
+	writeFile("assets/logo.bin", "\x00\x01\x02")()
+	writeFile("notes.tmp", "ignored")()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoDirectory(projectName, gitRepoName, sourceDirectory),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "file_hashes.%", "2"),
+					resource.TestCheckResourceAttrSet(tfNode, "file_hashes./site/assets/logo.bin"),
+					resource.TestCheckResourceAttr(tfNode, "untracked_files.#", "0"),
+				),
+			},
+			{
+				PreConfig: writeFile("index.html", "<html><body></body></html>"),
+				Config:    hclGitRepoDirectory(projectName, gitRepoName, sourceDirectory),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "file_hashes.%", "2"),
+					// the git object ID of the updated file
+					resource.TestCheckResourceAttr(tfNode, "file_hashes./site/index.html", "42682b4746225a1fa7df6f272925245827119f42"),
+				),
+			},
+		},
+	})
+}
+
+func hclGitRepoDirectory(projectName string, gitRepoName string, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository_directory" "directory" {
+  repository_id    = azuredevops_git_repository.repository.id
+  branch           = "refs/heads/master"
+  source_directory = "%s"
+  target_path      = "/site"
+  exclude          = ["**/*.tmp"]
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"), filepath.ToSlash(sourceDirectory))
+}
@@ -65,6 +65,48 @@ func TestAccGitRepoFile_CreateAndUpdate(t *testing.T) {
 	})
 }
 
+// TestAccGitRepoFile_PullRequest verifies that a file change is merged through
+// an auto-completed pull request
+func TestAccGitRepoFile_PullRequest(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfRepoFileNode := "azuredevops_git_repository_file.file"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository_file" "file" {
+  repository_id = azuredevops_git_repository.repository.id
+  file          = "foo.txt"
+  content       = "bar"
+  branch        = "refs/heads/master"
+
+  pull_request {
+    title               = "Add foo.txt"
+    auto_complete       = true
+    merge_strategy      = "squash"
+    wait_for_completion = true
+  }
+
+  timeouts {
+    create = "5m"
+  }
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfRepoFileNode, "pull_request_id"),
+					resource.TestCheckResourceAttr(tfRepoFileNode, "content", "bar"),
+					checkGitRepoFileContent("bar"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccGitRepo_Create_IncorrectBranch verifies a file
 // can't be added to a non existant branch
 func TestAccGitRepoFile_Create_IncorrectBranch(t *testing.T) {
@@ -0,0 +1,132 @@
+//go:build (all || core || resource_git_repository_files) && !exclude_resource_git_repository_files
+// +build all core resource_git_repository_files
+// +build !exclude_resource_git_repository_files
+
+package acceptancetests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// TestAccGitRepoFiles_CreateUpdateDelete verifies that a set of files is
+// written in a single commit and that removed files are deleted
+func TestAccGitRepoFiles_CreateUpdateDelete(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_repository_files.files"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoFiles(projectName, gitRepoName, map[string]string{
+					"README.md":   "readme",
+					"src/main.go": "package main",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "file.#", "2"),
+					checkGitRepoFilesCommitCount(2),
+				),
+			},
+			{
+				Config: hclGitRepoFiles(projectName, gitRepoName, map[string]string{
+					"README.md": "updated",
+					"docs/a.md": "a",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "file.#", "2"),
+					checkGitRepoFilesCommitCount(3),
+					checkGitRepoFilesNotExist("src/main.go"),
+				),
+			},
+		},
+	})
+}
+
+// checkGitRepoFilesCommitCount verifies the number of commits of the repository, the initial
+// commit of the repository included
+func checkGitRepoFilesCommitCount(expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+		repo, ok := s.RootModule().Resources["azuredevops_git_repository.repository"]
+		if !ok {
+			return fmt.Errorf("Did not find a repo definition in the TF state")
+		}
+
+		commits, err := clients.GitReposClient.GetCommits(context.Background(), git.GetCommitsArgs{
+			RepositoryId: &repo.Primary.ID,
+			SearchCriteria: &git.GitQueryCommitsCriteria{
+				ItemVersion: &git.GitVersionDescriptor{
+					Version: converter.String("master"),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		if len(*commits) != expected {
+			return fmt.Errorf("Expected %d commits, found %d", expected, len(*commits))
+		}
+		return nil
+	}
+}
+
+func checkGitRepoFilesNotExist(paths ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+		repo, ok := s.RootModule().Resources["azuredevops_git_repository.repository"]
+		if !ok {
+			return fmt.Errorf("Did not find a repo definition in the TF state")
+		}
+
+		for _, path := range paths {
+			filePath := path
+			_, err := clients.GitReposClient.GetItem(context.Background(), git.GetItemArgs{
+				RepositoryId: &repo.Primary.ID,
+				Path:         &filePath,
+			})
+			if err == nil {
+				return fmt.Errorf("File %s should not exist", path)
+			}
+			if !strings.Contains(err.Error(), "could not be found in the repository") {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func hclGitRepoFiles(projectName string, gitRepoName string, files map[string]string) string {
+	var fileBlocks strings.Builder
+	for path, content := range files {
+		fileBlocks.WriteString(fmt.Sprintf(`
+  file {
+    path    = "%s"
+    content = "%s"
+  }
+`, path, content))
+	}
+
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository_files" "files" {
+  repository_id  = azuredevops_git_repository.repository.id
+  branch         = "refs/heads/master"
+  commit_message = "Seed repository"
+%s
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"), fileBlocks.String())
+}
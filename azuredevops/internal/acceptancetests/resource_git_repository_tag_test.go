@@ -0,0 +1,112 @@
+//go:build (all || core || resource_git_repository_tag) && !exclude_resource_git_repository_tag
+// +build all core resource_git_repository_tag
+// +build !exclude_resource_git_repository_tag
+
+package acceptancetests
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// TestAccGitRepoTag_CreateAndImport verifies that lightweight and annotated tags can be created,
+// imported and listed by the tags data source
+func TestAccGitRepoTag_CreateAndImport(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	lightweightNode := "azuredevops_git_repository_tag.lightweight"
+	annotatedNode := "azuredevops_git_repository_tag.annotated"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoTagsDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoTags(projectName, gitRepoName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(lightweightNode, "annotated", "false"),
+					resource.TestCheckResourceAttrSet(lightweightNode, "commit_id"),
+					resource.TestCheckResourceAttrPair(lightweightNode, "commit_id", lightweightNode, "object_id"),
+					resource.TestCheckResourceAttr(annotatedNode, "annotated", "true"),
+					resource.TestCheckResourceAttr(annotatedNode, "message", "Release v1"),
+					resource.TestCheckResourceAttrPair(annotatedNode, "commit_id", lightweightNode, "commit_id"),
+				),
+			},
+			{
+				ResourceName:      annotatedNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"ref_commit_id",
+				},
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_git_repository_tags" "tags" {
+  repository_id = azuredevops_git_repository.repository.id
+  depends_on    = [azuredevops_git_repository_tag.lightweight, azuredevops_git_repository_tag.annotated]
+}`, hclGitRepoTags(projectName, gitRepoName)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azuredevops_git_repository_tags.tags", "tags.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func checkGitRepoTagsDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_git_repository_tag" {
+			continue
+		}
+
+		parts := strings.SplitN(res.Primary.ID, ":", 2)
+		refs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(parts[0]),
+			Filter:       converter.String("tags/" + parts[1]),
+		})
+		if err != nil {
+			// the repository is destroyed together with its tags
+			continue
+		}
+		for _, ref := range refs.Value {
+			if ref.Name != nil && *ref.Name == "refs/tags/"+parts[1] {
+				return fmt.Errorf("Tag %s should not exist", parts[1])
+			}
+		}
+	}
+
+	return nil
+}
+
+func hclGitRepoTags(projectName string, gitRepoName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository_tag" "lightweight" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "v1-lightweight"
+  ref_branch    = azuredevops_git_repository.repository.default_branch
+}
+
+resource "azuredevops_git_repository_tag" "annotated" {
+  repository_id = azuredevops_git_repository.repository.id
+  name          = "v1"
+  ref_commit_id = azuredevops_git_repository_tag.lightweight.commit_id
+  annotated     = true
+  message       = "Release v1"
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"))
+}
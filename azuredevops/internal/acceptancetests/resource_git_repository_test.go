@@ -6,6 +6,7 @@ package acceptancetests
 
 import (
 	"fmt"
+	"os"
 	"regexp"
 	"testing"
 
@@ -67,6 +68,143 @@ func TestAccGitRepo_CreateAndUpdate(t *testing.T) {
 	})
 }
 
+// Verifies that a repository can be disabled and enabled again, and that the
+// repository statistics are exported for an initialized repository.
+func TestAccGitRepo_Disabled(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfRepoNode := "azuredevops_git_repository.repository"
+
+	hclGitRepoDisabled := func(disabled bool) string {
+		return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  disabled   = %t
+
+  include_statistics = true
+  initialization {
+    init_type = "Clean"
+  }
+}`, testutils.HclProjectResource(projectName), gitRepoName, disabled)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoDisabled(false),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "false"),
+					resource.TestCheckResourceAttr(tfRepoNode, "branch_count", "1"),
+					resource.TestCheckResourceAttrSet(tfRepoNode, "last_commit_date"),
+				),
+			},
+			{
+				Config: hclGitRepoDisabled(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "true"),
+					resource.TestCheckResourceAttr(tfRepoNode, "branch_count", "0"),
+				),
+			},
+			{
+				Config: hclGitRepoDisabled(false),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					resource.TestCheckResourceAttr(tfRepoNode, "disabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+// Verifies that a destroyed repository which was purged from the recycle bin
+// can be created again with the same name.
+func TestAccGitRepo_PurgeOnDestroy(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoRecycleBin(projectName, gitRepoName, "purge_on_destroy"),
+				Check:  checkGitRepoExists(gitRepoName),
+			},
+			{
+				Config: testutils.HclProjectResource(projectName),
+			},
+			{
+				Config: hclGitRepoRecycleBin(projectName, gitRepoName, "purge_on_destroy"),
+				Check:  checkGitRepoExists(gitRepoName),
+			},
+		},
+	})
+}
+
+// Verifies that a destroyed repository is restored from the recycle bin when
+// it is created again with the same name.
+func TestAccGitRepo_RestoreFromRecycleBin(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfRepoNode := "azuredevops_git_repository.repository"
+	var repoID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclGitRepoRecycleBin(projectName, gitRepoName, "restore_from_recycle_bin"),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					func(s *terraform.State) error {
+						repoID = s.RootModule().Resources[tfRepoNode].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testutils.HclProjectResource(projectName),
+			},
+			{
+				Config: hclGitRepoRecycleBin(projectName, gitRepoName, "restore_from_recycle_bin"),
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitRepoName),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources[tfRepoNode].Primary.ID; id != repoID {
+							return fmt.Errorf("Expected the restored repository %s, got %s", repoID, id)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func hclGitRepoRecycleBin(projectName string, gitRepoName string, option string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "repository" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  %s = true
+  initialization {
+    init_type = "Clean"
+  }
+}`, testutils.HclProjectResource(projectName), gitRepoName, option)
+}
+
 // Verifies that the create operation fails if the initialization is
 // not specified.
 func TestAccGitRepo_Create_IncorrectInitialization(t *testing.T) {
@@ -233,6 +371,52 @@ func TestAccGitRepo_PrivateImport_BranchNotEmpty(t *testing.T) {
 }
 
 // or not the definition (1) exists in the state and (2) exist in AzDO and (3) has the correct name
+// Verifies that a private repository can be imported with inline credentials.
+func TestAccGitRepo_PrivateImportWithCredentials_BranchNotEmpty(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	gitImportRepoName := testutils.GenerateResourceName()
+	tfImportRepoNode := "azuredevops_git_repository.import"
+
+	importGitRepoResource := fmt.Sprintf(`
+%s
+
+resource "azuredevops_git_repository" "import" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+  initialization {
+    init_type   = "Import"
+    source_type = "Git"
+    source_url  = azuredevops_git_repository.repository.remote_url
+    username    = "%s"
+    password    = "%s"
+  }
+}`, testutils.HclGitRepoResource(projectName, gitRepoName, "Clean"), gitImportRepoName,
+		os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_USERNAME"),
+		os.Getenv("AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testutils.PreCheck(t, &[]string{
+				"AZDO_GENERIC_GIT_SERVICE_CONNECTION_USERNAME",
+				"AZDO_GENERIC_GIT_SERVICE_CONNECTION_PASSWORD",
+			})
+		},
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkGitRepoDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: importGitRepoResource,
+				Check: resource.ComposeTestCheckFunc(
+					checkGitRepoExists(gitImportRepoName),
+					resource.TestCheckResourceAttr(tfImportRepoNode, "name", gitImportRepoName),
+					resource.TestCheckResourceAttr(tfImportRepoNode, "default_branch", "refs/heads/master"),
+				),
+			},
+		},
+	})
+}
+
 func checkGitRepoExists(expectedName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
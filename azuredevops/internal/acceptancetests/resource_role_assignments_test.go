@@ -0,0 +1,105 @@
+//go:build (all || permissions || resource_role_assignments) && (!exclude_permissions || !exclude_resource_role_assignments)
+// +build all permissions resource_role_assignments
+// +build !exclude_permissions !exclude_resource_role_assignments
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclEnvironmentRoleAssignments(projectName string, environmentName string, inherit bool, roleName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_group" "tf-project-readers" {
+	project_id = azuredevops_project.project.id
+	name       = "Readers"
+}
+
+resource "azuredevops_environment_role_assignments" "roles" {
+	project_id          = azuredevops_project.project.id
+	environment_id      = azuredevops_environment.environment.id
+	inherit_permissions = %t
+
+	assignment {
+		principal = data.azuredevops_group.tf-project-readers.id
+		role_name = "%s"
+	}
+}
+`, testutils.HclEnvironmentResource(projectName, environmentName), inherit, roleName)
+}
+
+func hclAgentPoolRoleAssignments(poolName string, roleName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_group" "project-collection-admins" {
+	name = "Project Collection Administrators"
+}
+
+resource "azuredevops_agent_pool_role_assignments" "roles" {
+	agent_pool_id = azuredevops_agent_pool.pool.id
+
+	assignment {
+		principal = data.azuredevops_group.project-collection-admins.id
+		role_name = "%s"
+	}
+}
+`, testutils.HclAgentPoolResource(poolName), roleName)
+}
+
+func TestAccEnvironmentRoleAssignments_SetAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	environmentName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_environment_role_assignments.roles"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclEnvironmentRoleAssignments(projectName, environmentName, false, "User"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "environment_id"),
+					resource.TestCheckResourceAttr(tfNode, "inherit_permissions", "false"),
+					resource.TestCheckResourceAttr(tfNode, "assignment.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "assignment.0.role_name", "User"),
+				),
+			},
+			{
+				Config: hclEnvironmentRoleAssignments(projectName, environmentName, true, "Administrator"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "inherit_permissions", "true"),
+					resource.TestCheckResourceAttr(tfNode, "assignment.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "assignment.0.role_name", "Administrator"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAgentPoolRoleAssignments_Set(t *testing.T) {
+	poolName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_agent_pool_role_assignments.roles"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclAgentPoolRoleAssignments(poolName, "Reader"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "agent_pool_id"),
+					resource.TestCheckResourceAttr(tfNode, "assignment.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "assignment.0.role_name", "Reader"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,176 @@
+//go:build (all || resource_secure_file) && !exclude_resource_secure_file
+// +build all resource_secure_file
+// +build !exclude_resource_secure_file
+
+package acceptancetests
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+)
+
+// Verifies that the following sequence of events occurrs without error:
+//
+//	(1) TF apply uploads a secure file
+//	(2) Secure file can be queried by ID and has expected name
+//	(3) TF apply renames the secure file without uploading it again
+//	(4) TF apply with changed content replaces the secure file
+//	(5) TF destroy deletes the secure file
+func TestAccSecureFile_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	secureFileNameFirst := testutils.GenerateResourceName()
+	secureFileNameSecond := testutils.GenerateResourceName()
+	tfNode := "azuredevops_secure_file.secure_file"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkSecureFileDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclSecureFileResource(projectName, secureFileNameFirst, "first content"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", secureFileNameFirst),
+					resource.TestCheckResourceAttr(tfNode, "allow_access", "true"),
+					resource.TestCheckResourceAttr(tfNode, "properties.owner", "terraform"),
+					resource.TestCheckResourceAttrSet(tfNode, "content_hash"),
+					checkSecureFileExists(tfNode, secureFileNameFirst),
+				),
+			},
+			{
+				Config: hclSecureFileResource(projectName, secureFileNameSecond, "first content"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", secureFileNameSecond),
+					checkSecureFileExists(tfNode, secureFileNameSecond),
+				),
+			},
+			{
+				Config: hclSecureFileResource(projectName, secureFileNameSecond, "second content"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", secureFileNameSecond),
+					checkSecureFileExists(tfNode, secureFileNameSecond),
+				),
+			},
+			{
+				ResourceName:            tfNode,
+				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64", "content_hash"},
+			},
+		},
+	})
+}
+
+// Verifies that a secure file can be looked up by name through the data source
+func TestAccSecureFile_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	secureFileName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_secure_file.secure_file"
+
+	config := fmt.Sprintf(`
+%s
+
+data "azuredevops_secure_file" "secure_file" {
+  project_id = azuredevops_project.project.id
+  name       = azuredevops_secure_file.secure_file.name
+}`, hclSecureFileResource(projectName, secureFileName, "content"))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(tfNode, "id", "azuredevops_secure_file.secure_file", "id"),
+					resource.TestCheckResourceAttr(tfNode, "allow_access", "true"),
+					resource.TestCheckResourceAttr(tfNode, "properties.owner", "terraform"),
+				),
+			},
+		},
+	})
+}
+
+func hclSecureFileResource(projectName string, secureFileName string, content string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_secure_file" "secure_file" {
+  project_id     = azuredevops_project.project.id
+  name           = "%s"
+  content_base64 = "%s"
+  allow_access   = true
+  properties = {
+    owner = "terraform"
+  }
+}`, testutils.HclProjectResource(projectName), secureFileName, base64.StdEncoding.EncodeToString([]byte(content)))
+}
+
+// Given the state node of a secure file, this will return a function that will check whether
+// or not the secure file (1) exists in the state and (2) exist in AzDO and (3) has the correct name
+func checkSecureFileExists(tfNode string, expectedName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find a secure file in the TF state")
+		}
+
+		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+		secureFileID, err := uuid.Parse(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Parse ID error, ID:  %v !. Error= %v", res.Primary.ID, err)
+		}
+
+		secureFile, err := clients.TaskAgentClientExtras.GetSecureFile(clients.Ctx, taskagentextras.GetSecureFileArgs{
+			Project:      converter.String(res.Primary.Attributes["project_id"]),
+			SecureFileId: &secureFileID,
+		})
+		if err != nil {
+			return fmt.Errorf("Secure file with ID=%s cannot be found!. Error=%v", secureFileID, err)
+		}
+
+		if secureFile == nil || secureFile.Name == nil || *secureFile.Name != expectedName {
+			return fmt.Errorf("Secure file with ID=%s does not have expected Name=%s", secureFileID, expectedName)
+		}
+
+		return nil
+	}
+}
+
+// verifies that secure files referenced in the state are destroyed. This will be invoked
+// *after* terraform destroys the resource but *before* the state is wiped clean.
+func checkSecureFileDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_secure_file" {
+			continue
+		}
+
+		secureFileID, err := uuid.Parse(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Secure file ID=%s cannot be parsed!. Error=%v", res.Primary.ID, err)
+		}
+
+		// indicates the secure file still exists - this should fail the test
+		secureFile, err := clients.TaskAgentClientExtras.GetSecureFile(clients.Ctx, taskagentextras.GetSecureFileArgs{
+			Project:      converter.String(res.Primary.Attributes["project_id"]),
+			SecureFileId: &secureFileID,
+		})
+		if err == nil && secureFile != nil && secureFile.Id != nil {
+			return fmt.Errorf("Secure file ID %s should not exist", secureFileID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+//go:build (all || resource_task_group) && !exclude_resource_task_group
+// +build all resource_task_group
+// +build !exclude_resource_task_group
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func TestAccTaskGroup_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	taskGroupName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_task_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkTaskGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclTaskGroup(projectName, taskGroupName, "echo first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", taskGroupName),
+					resource.TestCheckResourceAttr(tfNode, "input.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "task.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "task.0.inputs.script", "echo first"),
+					resource.TestCheckResourceAttr(tfNode, "version", "1"),
+				),
+			},
+			{
+				Config: hclTaskGroup(projectName, taskGroupName, "echo second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "task.0.inputs.script", "echo second"),
+					resource.TestCheckResourceAttr(tfNode, "version", "1"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: fmt.Sprintf(`
+%s
+
+data "azuredevops_task_group" "test" {
+  project_id = azuredevops_project.project.id
+  name       = azuredevops_task_group.test.name
+}`, hclTaskGroup(projectName, taskGroupName, "echo second")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.azuredevops_task_group.test", "id", tfNode, "id"),
+					resource.TestCheckResourceAttr("data.azuredevops_task_group.test", "version", "1"),
+				),
+			},
+		},
+	})
+}
+
+func checkTaskGroupDestroyed(s *terraform.State) error {
+	clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+
+	for _, res := range s.RootModule().Resources {
+		if res.Type != "azuredevops_task_group" {
+			continue
+		}
+
+		id, err := uuid.Parse(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Task group ID=%s cannot be parsed!. Error=%v", res.Primary.ID, err)
+		}
+
+		taskGroups, err := clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+			Project:     converter.String(res.Primary.Attributes["project_id"]),
+			TaskGroupId: &id,
+		})
+		if err == nil && taskGroups != nil && len(*taskGroups) > 0 {
+			return fmt.Errorf("Task group with ID %s should not exist", id.String())
+		}
+	}
+
+	return nil
+}
+
+func hclTaskGroup(projectName string, taskGroupName string, script string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_task_group" "test" {
+  project_id = azuredevops_project.project.id
+  name       = "%s"
+
+  input {
+    name          = "message"
+    default_value = "hello"
+  }
+
+  task {
+    task_id = "d9bafed4-0b18-4f58-968d-86655b4d2ce9"
+    version = "2.*"
+    inputs = {
+      script = "%s"
+    }
+  }
+}`, testutils.HclProjectResource(projectName), taskGroupName, script)
+}
@@ -94,7 +94,34 @@ func TestAccVariableGroupKeyVault_CreateAndUpdate(t *testing.T) {
 				ImportStateIdFunc:       testutils.ComputeProjectQualifiedResourceImportID(tfVarGroupNode),
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"key_vault.0.search_depth"},
+				ImportStateVerifyIgnore: []string{"key_vault.0.search_depth", "key_vault.0.sync_all_secrets"},
+			},
+		},
+	})
+}
+
+func TestAccVariableGroupKeyVault_SyncAllSecrets(t *testing.T) {
+	t.Skip("Skipping test TestAccVariableGroupKeyVault_SyncAllSecrets: azure key vault not provisioned on test infrastructure")
+	projectName := testutils.GenerateResourceName()
+
+	vargroupKeyvault := testutils.GenerateResourceName()
+	keyVaultName := "key-vault-name"
+	tfVarGroupNode := "azuredevops_variable_group.vg"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: checkVariableGroupDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclVariableGroupResourceKeyVaultSyncAllSecrets(projectName, vargroupKeyvault, keyVaultName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfVarGroupNode, "name", vargroupKeyvault),
+					resource.TestCheckResourceAttr(tfVarGroupNode, "key_vault.0.sync_all_secrets", "true"),
+					resource.TestCheckResourceAttrSet(tfVarGroupNode, "key_vault.0.linked_secrets.0.name"),
+					resource.TestCheckResourceAttrSet(tfVarGroupNode, "variable.#"),
+					checkVariableGroupExists(vargroupKeyvault, false),
+				),
 			},
 		},
 	})
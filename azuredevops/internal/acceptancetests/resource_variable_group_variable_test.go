@@ -0,0 +1,121 @@
+//go:build (all || resource_variable_group_variable) && !exclude_resource_variable_group_variable
+// +build all resource_variable_group_variable
+// +build !exclude_resource_variable_group_variable
+
+package acceptancetests
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+)
+
+// Verifies that variables managed by azuredevops_variable_group_variable resources are kept
+// by a variable group which ignores unmanaged variables, and that they can be updated and imported.
+func TestAccVariableGroupVariable_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	variableGroupName := testutils.GenerateResourceName()
+	tfVariableNode := "azuredevops_variable_group_variable.variable"
+	tfSecretNode := "azuredevops_variable_group_variable.secret"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclVariableGroupVariableResource(projectName, variableGroupName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfVariableNode, "name", "managed_separately"),
+					resource.TestCheckResourceAttr(tfVariableNode, "value", "value1"),
+					resource.TestCheckResourceAttr(tfSecretNode, "is_secret", "true"),
+					resource.TestCheckResourceAttr("azuredevops_variable_group.vg", "variable.#", "1"),
+					checkVariableGroupHasVariables("azuredevops_variable_group.vg", "owned_by_group", "managed_separately", "secret_managed_separately"),
+				),
+			},
+			{
+				Config: hclVariableGroupVariableResource(projectName, variableGroupName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfVariableNode, "value", "value2"),
+					resource.TestCheckResourceAttr("azuredevops_variable_group.vg", "variable.#", "1"),
+					checkVariableGroupHasVariables("azuredevops_variable_group.vg", "owned_by_group", "managed_separately", "secret_managed_separately"),
+				),
+			},
+			{
+				ResourceName:      tfVariableNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func hclVariableGroupVariableResource(projectName string, variableGroupName string, value string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_variable_group" "vg" {
+  project_id                 = azuredevops_project.project.id
+  name                       = "%s"
+  ignore_unmanaged_variables = true
+
+  variable {
+    name  = "owned_by_group"
+    value = "value"
+  }
+}
+
+resource "azuredevops_variable_group_variable" "variable" {
+  project_id        = azuredevops_project.project.id
+  variable_group_id = azuredevops_variable_group.vg.id
+  name              = "managed_separately"
+  value             = "%s"
+}
+
+resource "azuredevops_variable_group_variable" "secret" {
+  project_id        = azuredevops_project.project.id
+  variable_group_id = azuredevops_variable_group.vg.id
+  name              = "secret_managed_separately"
+  secret_value      = "secret"
+  is_secret         = true
+}`, testutils.HclProjectResource(projectName), variableGroupName, value)
+}
+
+// checkVariableGroupHasVariables verifies that the variable group in AzDO contains exactly the expected variables
+func checkVariableGroupHasVariables(tfNode string, expectedNames ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		res, ok := s.RootModule().Resources[tfNode]
+		if !ok {
+			return fmt.Errorf("Did not find a variable group in the TF state")
+		}
+
+		variableGroupID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return err
+		}
+		projectID := res.Primary.Attributes["project_id"]
+		clients := testutils.GetProvider().Meta().(*client.AggregatedClient)
+		variableGroup, err := clients.TaskAgentClient.GetVariableGroup(clients.Ctx, taskagent.GetVariableGroupArgs{
+			GroupId: &variableGroupID,
+			Project: &projectID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if variableGroup.Variables == nil || len(*variableGroup.Variables) != len(expectedNames) {
+			return fmt.Errorf("Variable group %d has unexpected variables %v, expected %v", variableGroupID, variableGroup.Variables, expectedNames)
+		}
+		for _, name := range expectedNames {
+			if _, ok := (*variableGroup.Variables)[name]; !ok {
+				return fmt.Errorf("Variable group %d does not contain variable %s", variableGroupID, name)
+			}
+		}
+		return nil
+	}
+}
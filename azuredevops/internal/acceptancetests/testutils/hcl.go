@@ -639,6 +639,25 @@ resource "azuredevops_variable_group" "vg" {
 }`, variableGroupName, allowAccess, keyVaultName)
 }
 
+// HclVariableGroupResourceKeyVaultSyncAllSecrets HCL describing an AzDO variable group linking all secrets of a key vault
+func HclVariableGroupResourceKeyVaultSyncAllSecrets(projectName string, variableGroupName string, keyVaultName string) string {
+	projectAndServiceEndpoint := HclServiceEndpointAzureRMResource(projectName, "test-service-connection", "e318e66b-ec4b-4dff-9124-41129b9d7150", "d9d210dd-f9f0-4176-afb8-a4df60e1ae72")
+
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_variable_group" "vg" {
+	project_id  = azuredevops_project.project.id
+	name        = "%s"
+	description = "A sample variable group."
+	key_vault {
+		name                = "%s"
+		service_endpoint_id = azuredevops_serviceendpoint_azurerm.serviceendpointrm.id
+		sync_all_secrets    = true
+	}
+}`, projectAndServiceEndpoint, variableGroupName, keyVaultName)
+}
+
 // HclVariableGroupDataSource HCL describing a data source for an AzDO Variable Group
 func HclVariableGroupDataSource() string {
 	return `
@@ -696,6 +715,16 @@ data "azuredevops_agent_queue" "queue" {
 }`, HclProjectResource(projectName), queueName)
 }
 
+// HclAgentQueuesDataSource HCL describing a data source for all AzDO Agent Queues of a project
+func HclAgentQueuesDataSource(projectName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_agent_queues" "queues" {
+	project_id = azuredevops_project.project.id
+}`, HclProjectResource(projectName))
+}
+
 // HclAgentQueueResource HCL describing an AzDO Agent Pool and Agent Queue
 func HclAgentQueueResource(projectName, poolName string) string {
 	poolHCL := HclAgentPoolResource(poolName)
@@ -62,10 +62,14 @@ func getCheckFromState(resource *terraform.ResourceState) (*pipelineschecks.Chec
 		return nil, err
 	}
 
-	projectID := resource.Primary.Attributes["project_id"]
+	args := pipelineschecks.GetCheckConfigurationArgs{
+		Id: &branchControlCheckID,
+	}
+	// checks on organization scoped resources (e.g. agent pools) have no project
+	if projectID := resource.Primary.Attributes["project_id"]; projectID != "" {
+		args.Project = &projectID
+	}
+
 	clients := GetProvider().Meta().(*client.AggregatedClient)
-	return clients.V5PipelinesChecksClientExtras.GetCheckConfiguration(clients.Ctx, pipelineschecks.GetCheckConfigurationArgs{
-		Project: &projectID,
-		Id:      &branchControlCheckID,
-	})
+	return clients.V5PipelinesChecksClientExtras.GetCheckConfiguration(clients.Ctx, args)
 }
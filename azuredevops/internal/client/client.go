@@ -26,7 +26,10 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/gitextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/pipelineschecksextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/securityroles"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
 	"github.com/microsoft/terraform-provider-azuredevops/version"
 )
 
@@ -42,6 +45,7 @@ type AggregatedClient struct {
 	CoreClient                    core.Client
 	BuildClient                   build.Client
 	GitReposClient                git.Client
+	GitReposClientExtras          gitextras.Client
 	GraphClient                   graph.Client
 	V5GraphClient                 v5graph.Client
 	OperationsClient              operations.Client
@@ -51,10 +55,12 @@ type AggregatedClient struct {
 	ReleaseClient                 release.Client
 	ServiceEndpointClient         serviceendpoint.Client
 	TaskAgentClient               taskagent.Client
+	TaskAgentClientExtras         taskagentextras.Client
 	V5TaskAgentClient             v5taskagent.Client
 	MemberEntitleManagementClient memberentitlementmanagement.Client
 	FeatureManagementClient       featuremanagement.Client
 	SecurityClient                security.Client
+	SecurityRolesClient           securityroles.Client
 	IdentityClient                identity.Client
 	WorkItemTrackingClient        workitemtracking.Client
 	Ctx                           context.Context
@@ -111,6 +117,12 @@ func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*A
 		log.Printf("getAzdoClient(): taskagent.NewClient failed.")
 		return nil, err
 	}
+	// client for the distributed task APIs not covered by the SDK (includes CRUD for AzDO secure files):
+	taskagentClientExtras, err := taskagentextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): taskagentextras.NewClient failed.")
+		return nil, err
+	}
 	// client for these APIs (includes CRUD for AzDO variable groups):
 	v5TaskAgentClient, err := v5taskagent.NewClient(ctx, v5Connection)
 	if err != nil {
@@ -126,6 +138,12 @@ func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*A
 		return nil, err
 	}
 
+	gitReposClientExtras, err := gitextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): gitextras.NewClient failed.")
+		return nil, err
+	}
+
 	//  https://docs.microsoft.com/en-us/rest/api/azure/devops/graph/?view=azure-devops-rest-5.1
 	graphClient, err := graph.NewClient(ctx, connection)
 	if err != nil {
@@ -162,6 +180,7 @@ func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*A
 	}
 
 	securityClient := security.NewClient(ctx, connection)
+	securityRolesClient := securityroles.NewClient(ctx, connection)
 	identityClient, err := identity.NewClient(ctx, connection)
 	if err != nil {
 		log.Printf("getAzdoClient(): identity.NewClient failed.")
@@ -193,6 +212,7 @@ func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*A
 		CoreClient:                    coreClient,
 		BuildClient:                   buildClient,
 		GitReposClient:                gitReposClient,
+		GitReposClientExtras:          gitReposClientExtras,
 		GraphClient:                   graphClient,
 		V5GraphClient:                 v5GraphClient,
 		OperationsClient:              operationsClient,
@@ -202,10 +222,12 @@ func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*A
 		ReleaseClient:                 releaseClient,
 		ServiceEndpointClient:         serviceEndpointClient,
 		TaskAgentClient:               taskagentClient,
+		TaskAgentClientExtras:         taskagentClientExtras,
 		V5TaskAgentClient:             v5TaskAgentClient,
 		MemberEntitleManagementClient: memberentitlementmanagementClient,
 		FeatureManagementClient:       featuremanagementClient,
 		SecurityClient:                securityClient,
+		SecurityRolesClient:           securityRolesClient,
 		IdentityClient:                identityClient,
 		WorkItemTrackingClient:        workitemtrackingClient,
 		Ctx:                           ctx,
@@ -19,9 +19,11 @@ var taskCheckType = pipelineschecks.CheckType{
 	Id: converter.UUID("fe1de3ee-a436-41b4-bb20-f6eb4cb879a7"),
 }
 
-// NOTE: In theory the API should accept "agentpool" as well, but the API client requires a project ID
-// so it doesn't seem to work and the website UI doesn't have it available
-var targetResourceTypes = []string{"endpoint", "environment", "queue", "repository", "securefile", "variablegroup"}
+var targetResourceTypes = []string{"agentpool", "endpoint", "environment", "queue", "repository", "securefile", "variablegroup"}
+
+// Agent pools are organization level resources, so checks on them are managed
+// through the organization scoped API and are not associated with a project
+var organizationScopedResourceTypes = []string{"agentpool"}
 
 type flatFunc func(d *schema.ResourceData, check *pipelineschecks.CheckConfiguration, projectID string) error
 type expandFunc func(d *schema.ResourceData) (*pipelineschecks.CheckConfiguration, string, error)
@@ -43,7 +45,7 @@ func genBaseCheckResource(f flatFunc, e expandFunc) *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
-				Required:     true,
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.IsUUID,
 			},
@@ -72,6 +74,15 @@ func genBaseCheckResource(f flatFunc, e expandFunc) *schema.Resource {
 // doBaseExpansion performs the expansion for the 'base' attributes that are defined in the schema, above
 func doBaseExpansion(d *schema.ResourceData, inputs map[string]interface{}, definitionRef interface{}) (*pipelineschecks.CheckConfiguration, string, error) {
 	projectID := d.Get("project_id").(string)
+	targetResourceType := d.Get("target_resource_type").(string)
+
+	if isOrganizationScopedResourceType(targetResourceType) {
+		if projectID != "" {
+			return nil, "", fmt.Errorf("project_id must not be set when target_resource_type is %s", targetResourceType)
+		}
+	} else if projectID == "" {
+		return nil, "", fmt.Errorf("project_id is required when target_resource_type is %s", targetResourceType)
+	}
 
 	taskCheck := pipelineschecks.CheckConfiguration{
 		Type: &taskCheckType,
@@ -82,7 +93,7 @@ func doBaseExpansion(d *schema.ResourceData, inputs map[string]interface{}, defi
 		},
 		Resource: &pipelineschecks.Resource{
 			Id:   converter.String(d.Get("target_resource_id").(string)),
-			Type: converter.String(d.Get("target_resource_type").(string)),
+			Type: converter.String(targetResourceType),
 		},
 	}
 
@@ -151,12 +162,22 @@ func genCheckCreateFunc(flatFunc flatFunc, expandFunc expandFunc) func(d *schema
 			return fmt.Errorf(" failed in expandFunc. Error: %+v", err)
 		}
 
-		createdCheck, err := clients.V5PipelinesChecksClient.AddCheckConfiguration(clients.Ctx, pipelineschecks.AddCheckConfigurationArgs{
-			Project:       &projectID,
-			Configuration: configuration,
-		})
-		if err != nil {
-			return fmt.Errorf(" failed creating check, project ID: %s. Error: %+v", projectID, err)
+		var createdCheck *pipelineschecks.CheckConfiguration
+		if isOrganizationScopedResourceType(*configuration.Resource.Type) {
+			createdCheck, err = clients.V5PipelinesChecksClientExtras.AddCheckConfiguration(clients.Ctx, pipelineschecks.AddCheckConfigurationArgs{
+				Configuration: configuration,
+			})
+			if err != nil {
+				return fmt.Errorf(" failed creating check on organization scoped resource. Error: %+v", err)
+			}
+		} else {
+			createdCheck, err = clients.V5PipelinesChecksClient.AddCheckConfiguration(clients.Ctx, pipelineschecks.AddCheckConfigurationArgs{
+				Project:       &projectID,
+				Configuration: configuration,
+			})
+			if err != nil {
+				return fmt.Errorf(" failed creating check, project ID: %s. Error: %+v", projectID, err)
+			}
 		}
 
 		err = flatFunc(d, createdCheck, projectID)
@@ -175,10 +196,14 @@ func genCheckReadFunc(flatFunc flatFunc) func(d *schema.ResourceData, m interfac
 			return err
 		}
 
-		taskCheck, err := clients.V5PipelinesChecksClientExtras.GetCheckConfiguration(clients.Ctx, pipelineschecks.GetCheckConfigurationArgs{
-			Project: &projectID,
-			Id:      &taskCheckId,
-		})
+		args := pipelineschecks.GetCheckConfigurationArgs{
+			Id: &taskCheckId,
+		}
+		if !isOrganizationScopedResourceType(d.Get("target_resource_type").(string)) {
+			args.Project = &projectID
+		}
+
+		taskCheck, err := clients.V5PipelinesChecksClientExtras.GetCheckConfiguration(clients.Ctx, args)
 
 		if err != nil {
 			if utils.ResponseWasNotFound(err) || strings.Contains(err.Error(), "does not exist.") {
@@ -200,18 +225,27 @@ func genCheckUpdateFunc(flatFunc flatFunc, expandFunc expandFunc) schema.UpdateF
 			return err
 		}
 
-		updatedBusinessHours, err := clients.V5PipelinesChecksClient.UpdateCheckConfiguration(clients.Ctx,
-			pipelineschecks.UpdateCheckConfigurationArgs{
-				Project:       &projectID,
-				Configuration: taskCheck,
-				Id:            taskCheck.Id,
-			})
+		var updatedCheck *pipelineschecks.CheckConfiguration
+		if isOrganizationScopedResourceType(*taskCheck.Resource.Type) {
+			updatedCheck, err = clients.V5PipelinesChecksClientExtras.UpdateCheckConfiguration(clients.Ctx,
+				pipelineschecks.UpdateCheckConfigurationArgs{
+					Configuration: taskCheck,
+					Id:            taskCheck.Id,
+				})
+		} else {
+			updatedCheck, err = clients.V5PipelinesChecksClient.UpdateCheckConfiguration(clients.Ctx,
+				pipelineschecks.UpdateCheckConfigurationArgs{
+					Project:       &projectID,
+					Configuration: taskCheck,
+					Id:            taskCheck.Id,
+				})
+		}
 
 		if err != nil {
 			return err
 		}
 
-		err = flatFunc(d, updatedBusinessHours, projectID)
+		err = flatFunc(d, updatedCheck, projectID)
 		if err != nil {
 			return err
 		}
@@ -231,6 +265,13 @@ func genCheckDeleteFunc() schema.DeleteFunc { //nolint:staticcheck
 			return err
 		}
 
+		if isOrganizationScopedResourceType(d.Get("target_resource_type").(string)) {
+			return clients.V5PipelinesChecksClientExtras.DeleteCheckConfiguration(clients.Ctx,
+				pipelineschecks.DeleteCheckConfigurationArgs{
+					Id: &BusinessHoursID,
+				})
+		}
+
 		return clients.V5PipelinesChecksClient.DeleteCheckConfiguration(m.(*client.AggregatedClient).Ctx,
 			pipelineschecks.DeleteCheckConfigurationArgs{
 				Project: &projectID,
@@ -238,3 +279,12 @@ func genCheckDeleteFunc() schema.DeleteFunc { //nolint:staticcheck
 			})
 	}
 }
+
+func isOrganizationScopedResourceType(resourceType string) bool {
+	for _, t := range organizationScopedResourceTypes {
+		if strings.EqualFold(t, resourceType) {
+			return true
+		}
+	}
+	return false
+}
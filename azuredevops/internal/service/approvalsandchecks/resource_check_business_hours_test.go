@@ -158,3 +158,151 @@ func TestCheckBusinessHours_Update_DoesNotSwallowError(t *testing.T) {
 	err := r.Update(resourceData, clients)
 	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
 }
+
+var CheckBusinessHoursAgentPoolID = "12"
+var agentPoolType = "agentpool"
+var agentPoolResource = pipelineschecks.Resource{
+	Id:   &CheckBusinessHoursAgentPoolID,
+	Type: &agentPoolType,
+}
+
+var CheckBusinessHoursAgentPoolTest = pipelineschecks.CheckConfiguration{
+	Id:       &CheckBusinessHoursID,
+	Type:     &taskCheckType,
+	Settings: CheckBusinessHoursSettings,
+	Resource: &agentPoolResource,
+}
+
+// verifies that the flatten/expand round trip yields the same business hours check for an agent pool
+func TestCheckBusinessHours_AgentPool_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBusinessHours().Schema, nil)
+	flattenBusinessHours(resourceData, &CheckBusinessHoursAgentPoolTest, "")
+
+	CheckBusinessHoursAfterRoundTrip, projectID, err := expandBusinessHours(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, CheckBusinessHoursAgentPoolTest, *CheckBusinessHoursAfterRoundTrip)
+	require.Empty(t, projectID)
+}
+
+// verifies that a project ID cannot be combined with an organization scoped agent pool
+func TestCheckBusinessHours_AgentPool_Expand_RejectsProjectID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBusinessHours().Schema, nil)
+	flattenBusinessHours(resourceData, &CheckBusinessHoursAgentPoolTest, CheckBusinessHoursProjectID)
+
+	_, _, err := expandBusinessHours(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "project_id must not be set")
+}
+
+// verifies that a project ID is required for project scoped resources
+func TestCheckBusinessHours_Expand_RequiresProjectID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBusinessHours().Schema, nil)
+	flattenBusinessHours(resourceData, &CheckBusinessHoursTest, "")
+
+	_, _, err := expandBusinessHours(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "project_id is required")
+}
+
+// verifies that checks on agent pools are created through the organization scoped API
+func TestCheckBusinessHours_AgentPool_Create_UsesOrganizationScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceCheckBusinessHours()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBusinessHours(resourceData, &CheckBusinessHoursAgentPoolTest, "")
+
+	pipelinesCheckClient := azdosdkmocks.NewPipelinesChecksClientExtrasV5(ctrl)
+	clients := &client.AggregatedClient{V5PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	expectedArgs := pipelineschecks.AddCheckConfigurationArgs{Configuration: &CheckBusinessHoursAgentPoolTest}
+	pipelinesCheckClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("AddCheckConfiguration() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "AddCheckConfiguration() Failed")
+}
+
+// verifies that checks on agent pools are read through the organization scoped API
+func TestCheckBusinessHours_AgentPool_Read_UsesOrganizationScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceCheckBusinessHours()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBusinessHours(resourceData, &CheckBusinessHoursAgentPoolTest, "")
+
+	pipelinesCheckClient := azdosdkmocks.NewPipelinesChecksClientExtrasV5(ctrl)
+	clients := &client.AggregatedClient{V5PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	expectedArgs := pipelineschecks.GetCheckConfigurationArgs{
+		Id: CheckBusinessHoursAgentPoolTest.Id,
+	}
+	pipelinesCheckClient.
+		EXPECT().
+		GetCheckConfiguration(clients.Ctx, expectedArgs).
+		Return(&CheckBusinessHoursAgentPoolTest, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "agentpool", resourceData.Get("target_resource_type"))
+	require.Equal(t, CheckBusinessHoursAgentPoolID, resourceData.Get("target_resource_id"))
+	require.Empty(t, resourceData.Get("project_id"))
+}
+
+// verifies that checks on agent pools are updated through the organization scoped API
+func TestCheckBusinessHours_AgentPool_Update_UsesOrganizationScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceCheckBusinessHours()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBusinessHours(resourceData, &CheckBusinessHoursAgentPoolTest, "")
+
+	pipelinesCheckClient := azdosdkmocks.NewPipelinesChecksClientExtrasV5(ctrl)
+	clients := &client.AggregatedClient{V5PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	expectedArgs := pipelineschecks.UpdateCheckConfigurationArgs{
+		Configuration: &CheckBusinessHoursAgentPoolTest,
+		Id:            &CheckBusinessHoursID,
+	}
+	pipelinesCheckClient.
+		EXPECT().
+		UpdateCheckConfiguration(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateCheckConfiguration() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateCheckConfiguration() Failed")
+}
+
+// verifies that checks on agent pools are deleted through the organization scoped API
+func TestCheckBusinessHours_AgentPool_Delete_UsesOrganizationScope(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceCheckBusinessHours()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenBusinessHours(resourceData, &CheckBusinessHoursAgentPoolTest, "")
+
+	pipelinesCheckClient := azdosdkmocks.NewPipelinesChecksClientExtrasV5(ctrl)
+	clients := &client.AggregatedClient{V5PipelinesChecksClientExtras: pipelinesCheckClient, Ctx: context.Background()}
+
+	expectedArgs := pipelineschecks.DeleteCheckConfigurationArgs{
+		Id: &CheckBusinessHoursID,
+	}
+	pipelinesCheckClient.
+		EXPECT().
+		DeleteCheckConfiguration(clients.Ctx, expectedArgs).
+		Return(errors.New("DeleteCheckConfiguration() Failed")).
+		Times(1)
+
+	err := r.Delete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteCheckConfiguration() Failed")
+}
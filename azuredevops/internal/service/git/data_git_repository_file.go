@@ -0,0 +1,157 @@
+package git
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryFile schema and implementation for reading a file of a git repository
+func DataGitRepositoryFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoryFileRead,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"file": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"branch": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"tag", "commit_id"},
+			},
+			"tag": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"branch", "commit_id"},
+			},
+			"commit_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringIsNotEmpty,
+				ConflictsWith: []string{"branch", "tag"},
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_base64": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"is_binary": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"encoding": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryFileRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	file := d.Get("file").(string)
+
+	// without a version the file is read from the default branch
+	var version *git.GitVersionDescriptor
+	if v, ok := d.GetOk("branch"); ok {
+		version = &git.GitVersionDescriptor{
+			Version:     converter.String(shortBranchName(v.(string))),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		}
+	} else if v, ok := d.GetOk("tag"); ok {
+		version = &git.GitVersionDescriptor{
+			Version:     converter.String(withoutPrefix(REF_TAG_PREFIX, v.(string))),
+			VersionType: &git.GitVersionTypeValues.Tag,
+		}
+	} else if v, ok := d.GetOk("commit_id"); ok {
+		version = &git.GitVersionDescriptor{
+			Version:     converter.String(v.(string)),
+			VersionType: &git.GitVersionTypeValues.Commit,
+		}
+	}
+
+	repoItem, err := getRepositoryFileItem(clients, repoId, file, version)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return fmt.Errorf("File %s not found in repository %s", file, repoId)
+		}
+		return fmt.Errorf("Query repository item failed, repositoryID: %s, file: %s . Error:  %+v", repoId, file, err)
+	}
+	if converter.ToBool(repoItem.IsFolder, false) {
+		return fmt.Errorf("%s is a folder in repository %s, not a file", file, repoId)
+	}
+
+	isBinary := false
+	encoding := 0
+	if repoItem.ContentMetadata != nil {
+		isBinary = converter.ToBool(repoItem.ContentMetadata.IsBinary, false)
+		encoding = converter.ToInt(repoItem.ContentMetadata.Encoding, 0)
+	}
+
+	// the content of binary files cannot be represented as a string, so it is downloaded as is
+	content := converter.ToString(repoItem.Content, "")
+	contentBytes := []byte(content)
+	if isBinary {
+		content = ""
+		contentBytes, err = getRepositoryFileRawContent(clients, repoId, file, repoItem.CommitId)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", repoId, file))
+	d.Set("content", content)
+	d.Set("content_base64", base64.StdEncoding.EncodeToString(contentBytes))
+	d.Set("object_id", repoItem.ObjectId)
+	d.Set("commit_id", repoItem.CommitId)
+	d.Set("is_binary", isBinary)
+	d.Set("encoding", encoding)
+	return nil
+}
+
+// getRepositoryFileRawContent downloads the content of the file at the commit
+func getRepositoryFileRawContent(clients *client.AggregatedClient, repoId, file string, commitId *string) ([]byte, error) {
+	reader, err := clients.GitReposClient.GetItemContent(clients.Ctx, git.GetItemContentArgs{
+		RepositoryId: &repoId,
+		Path:         &file,
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     commitId,
+			VersionType: &git.GitVersionTypeValues.Commit,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Download of file %s from repository %s failed. Error: %+v", file, repoId, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("Reading the content of file %s from repository %s failed. Error: %+v", file, repoId, err)
+	}
+	return content, nil
+}
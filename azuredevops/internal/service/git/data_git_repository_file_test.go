@@ -0,0 +1,134 @@
+//go:build (all || data_sources || data_git_repository_file) && (!exclude_data_sources || !exclude_data_git_repository_file)
+// +build all data_sources data_git_repository_file
+// +build !exclude_data_sources !exclude_data_git_repository_file
+
+package git
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that a text file is read at the tag with its content and object ID
+func TestDataSourceGitRepositoryFile_Read_TextFileAtTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	reposClient.
+		EXPECT().
+		GetItem(gomock.Any(), git.GetItemArgs{
+			RepositoryId:           &repoID,
+			Path:                   converter.String("CODEOWNERS"),
+			IncludeContent:         converter.Bool(true),
+			IncludeContentMetadata: converter.Bool(true),
+			VersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String("v1.0"),
+				VersionType: &git.GitVersionTypeValues.Tag,
+			},
+		}).
+		Return(&git.GitItem{
+			Content:         converter.String("* @team"),
+			ContentMetadata: &git.FileContentMetadata{Encoding: converter.Int(65001), IsBinary: converter.Bool(false)},
+			ObjectId:        converter.String("blob"),
+			CommitId:        converter.String("commit"),
+		}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": repoID,
+		"file":          "CODEOWNERS",
+		"tag":           "refs/tags/v1.0",
+	})
+	err := dataSourceGitRepositoryFileRead(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, "* @team", d.Get("content"))
+	require.Equal(t, "KiBAdGVhbQ==", d.Get("content_base64"))
+	require.Equal(t, "blob", d.Get("object_id"))
+	require.Equal(t, "commit", d.Get("commit_id"))
+	require.Equal(t, 65001, d.Get("encoding"))
+	require.False(t, d.Get("is_binary").(bool))
+}
+
+// verifies that the content of a binary file is downloaded at the commit it was read at
+func TestDataSourceGitRepositoryFile_Read_BinaryFileIsDownloaded(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	reposClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Return(&git.GitItem{
+			Content:         converter.String("garbled"),
+			ContentMetadata: &git.FileContentMetadata{IsBinary: converter.Bool(true)},
+			ObjectId:        converter.String("blob"),
+			CommitId:        converter.String("commit"),
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetItemContent(clients.Ctx, git.GetItemContentArgs{
+			RepositoryId: &repoID,
+			Path:         converter.String("logo.png"),
+			VersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String("commit"),
+				VersionType: &git.GitVersionTypeValues.Commit,
+			},
+		}).
+		Return(io.NopCloser(strings.NewReader("\x89PNG")), nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": repoID,
+		"file":          "logo.png",
+	})
+	err := dataSourceGitRepositoryFileRead(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", d.Get("content"))
+	require.Equal(t, "iVBORw==", d.Get("content_base64"))
+	require.True(t, d.Get("is_binary").(bool))
+}
+
+// verifies that reading a missing file is an error
+func TestDataSourceGitRepositoryFile_Read_ErrorIfNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": uuid.New().String(),
+		"file":          "missing.yml",
+		"branch":        "main",
+	})
+	err := dataSourceGitRepositoryFileRead(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "not found")
+}
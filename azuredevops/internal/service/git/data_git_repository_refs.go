@@ -0,0 +1,221 @@
+package git
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryRefs schema and implementation for the branches and tags of a git repository
+func DataGitRepositoryRefs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoryRefsRead,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"filter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"include_branches": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"include_tags": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"branches": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ahead_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"behind_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"is_default": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ref_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"annotated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"creator_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"creator_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryRefsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	filter := d.Get("filter").(string)
+
+	branches := []interface{}{}
+	if d.Get("include_branches").(bool) {
+		refs, err := getGitRepositoryRefs(clients, repoId, "heads/"+filter)
+		if err != nil {
+			return err
+		}
+
+		// the branch statistics are compared against the default branch
+		stats, err := clients.GitReposClient.GetBranches(clients.Ctx, git.GetBranchesArgs{
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return fmt.Errorf("Error getting branch statistics of repository %s: %w", repoId, err)
+		}
+		branches = flattenGitRepositoryRefsBranches(refs, stats)
+	}
+
+	tags := []interface{}{}
+	if d.Get("include_tags").(bool) {
+		refs, err := getGitRepositoryRefs(clients, repoId, "tags/"+filter)
+		if err != nil {
+			return err
+		}
+		tags = flattenGitRepositoryRefsTags(refs)
+	}
+
+	d.SetId(repoId)
+	if err := d.Set("branches", branches); err != nil {
+		return fmt.Errorf("Error setting branches: %+v", err)
+	}
+	if err := d.Set("tags", tags); err != nil {
+		return fmt.Errorf("Error setting tags: %+v", err)
+	}
+	return nil
+}
+
+func flattenGitRepositoryRefsBranches(refs []git.GitRef, stats *[]git.GitBranchStats) []interface{} {
+	statsByName := map[string]git.GitBranchStats{}
+	if stats != nil {
+		for _, stat := range *stats {
+			if stat.Name != nil {
+				statsByName[*stat.Name] = stat
+			}
+		}
+	}
+
+	results := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Name == nil || ref.ObjectId == nil {
+			continue
+		}
+
+		name := withoutPrefix(REF_BRANCH_PREFIX, *ref.Name)
+		creatorId, creatorName := flattenGitRefCreator(ref.Creator)
+		branch := map[string]interface{}{
+			"name":         name,
+			"ref_name":     *ref.Name,
+			"commit_id":    *ref.ObjectId,
+			"creator_id":   creatorId,
+			"creator_name": creatorName,
+			"ahead_count":  0,
+			"behind_count": 0,
+			"is_default":   false,
+		}
+		if stat, ok := statsByName[name]; ok {
+			branch["ahead_count"] = converter.ToInt(stat.AheadCount, 0)
+			branch["behind_count"] = converter.ToInt(stat.BehindCount, 0)
+			branch["is_default"] = converter.ToBool(stat.IsBaseVersion, false)
+		}
+		results = append(results, branch)
+	}
+	return results
+}
+
+func flattenGitRepositoryRefsTags(refs []git.GitRef) []interface{} {
+	// flattenGitRepositoryTags skips incomplete refs, which must be skipped here as well to keep the results aligned
+	validRefs := make([]git.GitRef, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Name != nil && ref.ObjectId != nil {
+			validRefs = append(validRefs, ref)
+		}
+	}
+
+	results := flattenGitRepositoryTags(validRefs)
+	for i, ref := range validRefs {
+		tag := results[i].(map[string]interface{})
+		tag["ref_name"] = *ref.Name
+		tag["creator_id"], tag["creator_name"] = flattenGitRefCreator(ref.Creator)
+	}
+	return results
+}
+
+func flattenGitRefCreator(creator *webapi.IdentityRef) (string, string) {
+	if creator == nil {
+		return "", ""
+	}
+	return converter.ToString(creator.Id, ""), converter.ToString(creator.DisplayName, "")
+}
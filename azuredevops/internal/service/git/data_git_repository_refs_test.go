@@ -0,0 +1,96 @@
+//go:build (all || data_sources || data_git_repository_refs) && (!exclude_data_sources || !exclude_data_git_repository_refs)
+// +build all data_sources data_git_repository_refs
+// +build !exclude_data_sources !exclude_data_git_repository_refs
+
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the branches matching the filter are joined with their statistics against the default
+// branch, and that the tags matching the filter are peeled to their commit
+func TestDataSourceGitRepositoryRefs_Read_JoinsBranchStatistics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	creator := &webapi.IdentityRef{Id: converter.String("creator"), DisplayName: converter.String("Creator")}
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: &repoID,
+			Filter:       converter.String("heads/release/"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/heads/release/1"), ObjectId: converter.String("commit1"), Creator: creator}},
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetBranches(clients.Ctx, git.GetBranchesArgs{RepositoryId: &repoID}).
+		Return(&[]git.GitBranchStats{
+			{Name: converter.String("main"), AheadCount: converter.Int(0), BehindCount: converter.Int(0), IsBaseVersion: converter.Bool(true)},
+			{Name: converter.String("release/1"), AheadCount: converter.Int(2), BehindCount: converter.Int(5), IsBaseVersion: converter.Bool(false)},
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: &repoID,
+			Filter:       converter.String("tags/release/"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{
+				{Name: converter.String("refs/tags/release/1.0")},
+				{Name: converter.String("refs/tags/release/1.1"), ObjectId: converter.String("tag1"), PeeledObjectId: converter.String("commit1")},
+			},
+		}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryRefs().Schema, map[string]interface{}{
+		"repository_id": repoID,
+		"filter":        "release/",
+	})
+	err := dataSourceGitRepositoryRefsRead(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"name":         "release/1",
+			"ref_name":     "refs/heads/release/1",
+			"commit_id":    "commit1",
+			"creator_id":   "creator",
+			"creator_name": "Creator",
+			"ahead_count":  2,
+			"behind_count": 5,
+			"is_default":   false,
+		},
+	}, d.Get("branches"))
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"name":         "release/1.1",
+			"ref_name":     "refs/tags/release/1.1",
+			"object_id":    "tag1",
+			"commit_id":    "commit1",
+			"annotated":    true,
+			"creator_id":   "",
+			"creator_name": "",
+		},
+	}, d.Get("tags"))
+}
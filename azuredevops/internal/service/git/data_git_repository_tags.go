@@ -0,0 +1,109 @@
+package git
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataGitRepositoryTags schema and implementation for the tags of a git repository
+func DataGitRepositoryTags() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceGitRepositoryTagsRead,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"object_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"commit_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"annotated": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGitRepositoryTagsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	refs, err := getGitRepositoryRefs(clients, repoId, "tags/")
+	if err != nil {
+		return err
+	}
+
+	d.SetId(repoId)
+	if err := d.Set("tags", flattenGitRepositoryTags(refs)); err != nil {
+		return fmt.Errorf("Error setting tags: %+v", err)
+	}
+	return nil
+}
+
+// getGitRepositoryRefs returns all refs of the repository starting with the filter, with annotated tags peeled
+func getGitRepositoryRefs(clients *client.AggregatedClient, repoId, filter string) ([]git.GitRef, error) {
+	refs := []git.GitRef{}
+	var continuationToken *string
+	for {
+		gotRefs, err := clients.GitReposClient.GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId:      converter.String(repoId),
+			Filter:            converter.String(filter),
+			PeelTags:          converter.Bool(true),
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error getting refs matching %q: %w", filter, err)
+		}
+		refs = append(refs, gotRefs.Value...)
+		if gotRefs.ContinuationToken == "" {
+			return refs, nil
+		}
+		continuationToken = converter.String(gotRefs.ContinuationToken)
+	}
+}
+
+func flattenGitRepositoryTags(refs []git.GitRef) []interface{} {
+	results := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		if ref.Name == nil || ref.ObjectId == nil {
+			continue
+		}
+
+		annotated := ref.PeeledObjectId != nil && *ref.PeeledObjectId != ""
+		commitId := *ref.ObjectId
+		if annotated {
+			commitId = *ref.PeeledObjectId
+		}
+		results = append(results, map[string]interface{}{
+			"name":      withoutPrefix(REF_TAG_PREFIX, *ref.Name),
+			"object_id": *ref.ObjectId,
+			"commit_id": commitId,
+			"annotated": annotated,
+		})
+	}
+	return results
+}
@@ -0,0 +1,64 @@
+//go:build (all || data_sources || data_git_repository_tags) && (!exclude_data_sources || !exclude_data_git_repository_tags)
+// +build all data_sources data_git_repository_tags
+// +build !exclude_data_sources !exclude_data_git_repository_tags
+
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that all pages of tags are read and annotated tags are peeled to their commit
+func TestDataSourceGitRepositoryTags_Read_PeelsAnnotatedTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	repoID := uuid.New().String()
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: &repoID,
+			Filter:       converter.String("tags/"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value:             []git.GitRef{{Name: converter.String("refs/tags/v1"), ObjectId: converter.String("commit1")}},
+			ContinuationToken: "next",
+		}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId:      &repoID,
+			Filter:            converter.String("tags/"),
+			PeelTags:          converter.Bool(true),
+			ContinuationToken: converter.String("next"),
+		}).
+		Return(&git.GetRefsResponseValue{
+			Value: []git.GitRef{{Name: converter.String("refs/tags/v2"), ObjectId: converter.String("tag2"), PeeledObjectId: converter.String("commit2")}},
+		}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataGitRepositoryTags().Schema, map[string]interface{}{
+		"repository_id": repoID,
+	})
+	err := dataSourceGitRepositoryTagsRead(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"name": "v1", "object_id": "commit1", "commit_id": "commit1", "annotated": false},
+		map[string]interface{}{"name": "v2", "object_id": "tag2", "commit_id": "commit2", "annotated": true},
+	}, d.Get("tags"))
+}
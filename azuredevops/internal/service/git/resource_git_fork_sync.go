@@ -0,0 +1,231 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceGitForkSync schema to sync a fork with its source repository
+func ResourceGitForkSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitForkSyncCreate,
+		ReadContext:   resourceGitForkSyncRead,
+		DeleteContext: resourceGitForkSyncDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"source_repository_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"ref_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_ref": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"target_ref": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+					},
+				},
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"operation_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitForkSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	source, err := getGitForkSyncSource(clients, repoId, d.Get("source_repository_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	params := &git.GitForkSyncRequestParameters{Source: source}
+	// without ref mappings all refs of the source repository are synchronized
+	if mappings := d.Get("ref_mapping").([]interface{}); len(mappings) > 0 {
+		refs := make([]git.SourceToTargetRef, 0, len(mappings))
+		for _, mapping := range mappings {
+			values := mapping.(map[string]interface{})
+			refs = append(refs, git.SourceToTargetRef{
+				SourceRef: converter.String(expandGitForkSyncRef(values["source_ref"].(string))),
+				TargetRef: converter.String(expandGitForkSyncRef(values["target_ref"].(string))),
+			})
+		}
+		params.SourceToTargetRefs = &refs
+	}
+
+	syncRequest, err := clients.GitReposClient.CreateForkSyncRequest(clients.Ctx, git.CreateForkSyncRequestArgs{
+		SyncParams:         params,
+		RepositoryNameOrId: converter.String(repoId),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error creating fork sync request for repository %s: %w", repoId, err))
+	}
+	if syncRequest == nil || syncRequest.OperationId == nil {
+		return diag.FromErr(fmt.Errorf("Fork sync request for repository %s returned no operation ID", repoId))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%d", repoId, *syncRequest.OperationId))
+	d.Set("operation_id", *syncRequest.OperationId)
+	d.Set("source_repository_id", source.RepositoryId.String())
+
+	if err := waitForGitForkSyncRequest(clients, repoId, *syncRequest.OperationId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceGitForkSyncRead(ctx, d, m)
+}
+
+func resourceGitForkSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+	operationId := d.Get("operation_id").(int)
+
+	syncRequest, err := clients.GitReposClient.GetForkSyncRequest(clients.Ctx, git.GetForkSyncRequestArgs{
+		RepositoryNameOrId:  converter.String(repoId),
+		ForkSyncOperationId: converter.Int(operationId),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("Error reading fork sync request %d of repository %s: %w", operationId, repoId, err))
+	}
+
+	if syncRequest.Status != nil {
+		d.Set("status", string(*syncRequest.Status))
+	}
+	if syncRequest.Source != nil && syncRequest.Source.RepositoryId != nil {
+		d.Set("source_repository_id", syncRequest.Source.RepositoryId.String())
+	}
+	return nil
+}
+
+// resourceGitForkSyncDelete only removes the sync request from the state, a completed sync cannot be undone
+func resourceGitForkSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// getGitForkSyncSource returns the key of the source repository, which defaults to the parent of the fork
+func getGitForkSyncSource(clients *client.AggregatedClient, repoId, sourceRepoId string) (*git.GlobalGitRepositoryKey, error) {
+	if sourceRepoId == "" {
+		repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error reading repository %s: %w", repoId, err)
+		}
+		if repo.ParentRepository == nil || repo.ParentRepository.Id == nil {
+			return nil, fmt.Errorf("Repository %s is not a fork, source_repository_id must be set", repoId)
+		}
+		sourceRepoId = repo.ParentRepository.Id.String()
+	}
+
+	projectId, err := getGitRepositoryProjectId(clients, sourceRepoId)
+	if err != nil {
+		return nil, err
+	}
+	return &git.GlobalGitRepositoryKey{
+		ProjectId:    converter.UUID(projectId),
+		RepositoryId: converter.UUID(sourceRepoId),
+	}, nil
+}
+
+// expandGitForkSyncRef returns the full name of a ref, names without the refs/ prefix are treated as branches
+func expandGitForkSyncRef(ref string) string {
+	if strings.HasPrefix(ref, "refs/") {
+		return ref
+	}
+	return withPrefix(REF_BRANCH_PREFIX, ref)
+}
+
+// waitForGitForkSyncRequest waits until the sync request is completed. The detailed error message of a failed sync is returned.
+func waitForGitForkSyncRequest(clients *client.AggregatedClient, repoId string, operationId int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(git.GitAsyncOperationStatusValues.Queued),
+			string(git.GitAsyncOperationStatusValues.InProgress),
+		},
+		Target: []string{string(git.GitAsyncOperationStatusValues.Completed)},
+		Refresh: func() (interface{}, string, error) {
+			syncRequest, err := clients.GitReposClient.GetForkSyncRequest(clients.Ctx, git.GetForkSyncRequestArgs{
+				RepositoryNameOrId:  converter.String(repoId),
+				ForkSyncOperationId: converter.Int(operationId),
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("Error reading fork sync request %d: %w", operationId, err)
+			}
+			if syncRequest.Status == nil {
+				return syncRequest, string(git.GitAsyncOperationStatusValues.Queued), nil
+			}
+
+			status := *syncRequest.Status
+			if status == git.GitAsyncOperationStatusValues.Failed || status == git.GitAsyncOperationStatusValues.Abandoned {
+				message := "no details available"
+				if syncRequest.DetailedStatus != nil && syncRequest.DetailedStatus.ErrorMessage != nil {
+					message = *syncRequest.DetailedStatus.ErrorMessage
+				}
+				return nil, "", fmt.Errorf("Fork sync of repository %s %s: %s", repoId, status, message)
+			}
+			return syncRequest, string(status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf("Error waiting for the fork sync of repository %s: %w", repoId, err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+//go:build (all || git || resource_git_fork_sync) && (!exclude_git || !exclude_resource_git_fork_sync)
+// +build all git resource_git_fork_sync
+// +build !exclude_git !exclude_resource_git_fork_sync
+
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the fork is synced with its parent repository by default, with the refs mapped as configured
+func TestGitForkSync_Create_SyncsWithParentRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	forkID := uuid.New()
+	parentID := uuid.New()
+	parentProjectID := uuid.New()
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, git.GetRepositoryArgs{RepositoryId: converter.String(forkID.String())}).
+			Return(&git.GitRepository{Id: &forkID, ParentRepository: &git.GitRepositoryRef{Id: &parentID}}, nil),
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, git.GetRepositoryArgs{RepositoryId: converter.String(parentID.String())}).
+			Return(&git.GitRepository{Id: &parentID, Project: &core.TeamProjectReference{Id: &parentProjectID}}, nil),
+		reposClient.
+			EXPECT().
+			CreateForkSyncRequest(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args git.CreateForkSyncRequestArgs) (*git.GitForkSyncRequest, error) {
+				require.Equal(t, forkID.String(), *args.RepositoryNameOrId)
+				require.Equal(t, parentID, *args.SyncParams.Source.RepositoryId)
+				require.Equal(t, parentProjectID, *args.SyncParams.Source.ProjectId)
+				require.Equal(t, []git.SourceToTargetRef{{
+					SourceRef: converter.String("refs/heads/main"),
+					TargetRef: converter.String("refs/heads/upstream/main"),
+				}, {
+					SourceRef: converter.String("refs/tags/v1"),
+					TargetRef: converter.String("refs/tags/upstream/v1"),
+				}}, *args.SyncParams.SourceToTargetRefs)
+				return &git.GitForkSyncRequest{OperationId: converter.Int(9)}, nil
+			}),
+		reposClient.
+			EXPECT().
+			GetForkSyncRequest(clients.Ctx, git.GetForkSyncRequestArgs{
+				RepositoryNameOrId:  converter.String(forkID.String()),
+				ForkSyncOperationId: converter.Int(9),
+			}).
+			Return(&git.GitForkSyncRequest{Status: &git.GitAsyncOperationStatusValues.Completed}, nil).
+			Times(2),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitForkSync().Schema, map[string]interface{}{
+		"repository_id": forkID.String(),
+		"ref_mapping": []interface{}{map[string]interface{}{
+			"source_ref": "main",
+			"target_ref": "refs/heads/upstream/main",
+		}, map[string]interface{}{
+			"source_ref": "refs/tags/v1",
+			"target_ref": "refs/tags/upstream/v1",
+		}},
+	})
+	diags := resourceGitForkSyncCreate(context.Background(), d, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, forkID.String()+":9", d.Id())
+	require.Equal(t, parentID.String(), d.Get("source_repository_id"))
+	require.Equal(t, "completed", d.Get("status"))
+}
+
+// verifies that the detailed message of a failed sync is returned
+func TestGitForkSync_Create_ReturnsFailureMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	forkID := uuid.New()
+	sourceID := uuid.New()
+	sourceProjectID := uuid.New()
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, git.GetRepositoryArgs{RepositoryId: converter.String(sourceID.String())}).
+			Return(&git.GitRepository{Id: &sourceID, Project: &core.TeamProjectReference{Id: &sourceProjectID}}, nil),
+		reposClient.
+			EXPECT().
+			CreateForkSyncRequest(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args git.CreateForkSyncRequestArgs) (*git.GitForkSyncRequest, error) {
+				require.Nil(t, args.SyncParams.SourceToTargetRefs)
+				return &git.GitForkSyncRequest{OperationId: converter.Int(9)}, nil
+			}),
+		reposClient.
+			EXPECT().
+			GetForkSyncRequest(clients.Ctx, gomock.Any()).
+			Return(&git.GitForkSyncRequest{
+				Status:         &git.GitAsyncOperationStatusValues.Failed,
+				DetailedStatus: &git.GitForkOperationStatusDetail{ErrorMessage: converter.String("Ref conflict")},
+			}, nil),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitForkSync().Schema, map[string]interface{}{
+		"repository_id":        forkID.String(),
+		"source_repository_id": sourceID.String(),
+	})
+	diags := resourceGitForkSyncCreate(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "Ref conflict")
+}
+
+// verifies that a sync request without an operation ID is reported as an error
+func TestGitForkSync_Create_MissingOperationId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	forkID := uuid.New()
+	sourceID := uuid.New()
+	sourceProjectID := uuid.New()
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, git.GetRepositoryArgs{RepositoryId: converter.String(sourceID.String())}).
+			Return(&git.GitRepository{Id: &sourceID, Project: &core.TeamProjectReference{Id: &sourceProjectID}}, nil),
+		reposClient.
+			EXPECT().
+			CreateForkSyncRequest(clients.Ctx, gomock.Any()).
+			Return(&git.GitForkSyncRequest{}, nil),
+	)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitForkSync().Schema, map[string]interface{}{
+		"repository_id":        forkID.String(),
+		"source_repository_id": sourceID.String(),
+	})
+	diags := resourceGitForkSyncCreate(context.Background(), d, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "no operation ID")
+	require.Equal(t, "", d.Id())
+}
@@ -12,9 +12,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/gitextras"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
@@ -45,6 +47,9 @@ func ResourceGitRepository() *schema.Resource {
 		Update:   resourceGitRepositoryUpdate,
 		Delete:   resourceGitRepositoryDelete,
 		Importer: tfhelper.ImportProjectQualifiedResource(),
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:             schema.TypeString,
@@ -54,11 +59,10 @@ func ResourceGitRepository() *schema.Resource {
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
 			"name": {
-				Type:             schema.TypeString,
-				ForceNew:         false,
-				Required:         true,
-				ValidateFunc:     validation.NoZeroValues,
-				DiffSuppressFunc: suppress.CaseDifference,
+				Type:         schema.TypeString,
+				ForceNew:     false,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
 			},
 			"parent_repository_id": {
 				Type:             schema.TypeString,
@@ -66,16 +70,61 @@ func ResourceGitRepository() *schema.Resource {
 				ValidateFunc:     validation.IsUUID,
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			"fork_options": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"parent_repository_id"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"include_all_refs": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 			"default_branch": {
 				Type:         schema.TypeString,
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: validation.NoZeroValues,
 			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"restore_from_recycle_bin": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"purge_on_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"include_statistics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"is_fork": {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"branch_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_commit_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"remote_url": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -136,8 +185,36 @@ func ResourceGitRepository() *schema.Resource {
 								"initialization.0.source_url",
 								"initialization.0.source_type",
 							},
+							ConflictsWith: []string{
+								"initialization.0.password",
+								"initialization.0.personal_access_token",
+							},
 							Default: "",
 						},
+						"username": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							RequiredWith: []string{"initialization.0.source_url"},
+						},
+						"password": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							Sensitive:     true,
+							ValidateFunc:  validation.StringIsNotWhiteSpace,
+							RequiredWith:  []string{"initialization.0.username", "initialization.0.source_url"},
+							ConflictsWith: []string{"initialization.0.personal_access_token"},
+						},
+						"personal_access_token": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+							RequiredWith: []string{"initialization.0.source_url"},
+						},
 					},
 				},
 			},
@@ -151,6 +228,8 @@ type repoInitializationMeta struct {
 	sourceType          string
 	sourceURL           string
 	serviceConnectionID string
+	username            string
+	password            string
 }
 
 func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
@@ -162,6 +241,7 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	var parentRepoRef *git.GitRepositoryRef = nil
+	var sourceRef *string = nil
 	if parentRepoID, ok := d.GetOk("parent_repository_id"); ok {
 		parentRepo, err := gitRepositoryRead(clients, parentRepoID.(string), "", "")
 		if err != nil {
@@ -172,17 +252,44 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 			Name:    parentRepo.Name,
 			Project: parentRepo.Project,
 		}
+
+		// the fork may live in another project than its parent, all refs of the parent are forked by default
+		if forkOptions := d.Get("fork_options").([]interface{}); len(forkOptions) == 1 && forkOptions[0] != nil {
+			if !forkOptions[0].(map[string]interface{})["include_all_refs"].(bool) {
+				sourceRef = parentRepo.DefaultBranch
+			}
+		}
 	}
 
-	createdRepo, err := createGitRepository(clients, repo.Name, projectID, parentRepoRef)
-	if err != nil {
-		return fmt.Errorf("Error creating repository in Azure DevOps: %+v", err)
+	var createdRepo *git.GitRepository
+	if d.Get("restore_from_recycle_bin").(bool) {
+		createdRepo, err = restoreGitRepositoryFromRecycleBin(clients, *repo.Name, projectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	restored := createdRepo != nil
+	if restored {
+		// a restored repository keeps its content and its parent, so it must not be initialized or forked again
+		initialization = nil
+	} else {
+		createdRepo, err = createGitRepository(clients, repo.Name, projectID, parentRepoRef, sourceRef)
+		if err != nil {
+			return fmt.Errorf("Error creating repository in Azure DevOps: %+v", err)
+		}
 	}
 
 	// set the id immediately after successfully creating the repository, which will allow terraform to track the
 	// repository in state and will taint the resource if further errors are encountered during initialization
 	d.SetId(createdRepo.Id.String())
 
+	if restored {
+		if err := setRestoredGitRepositoryDefaultBranch(d, clients, createdRepo, projectID); err != nil {
+			return err
+		}
+	}
+
 	if initialization != nil && strings.EqualFold(initialization.initType, string(RepoInitTypeValues.Import)) &&
 		strings.EqualFold(initialization.sourceType, "Git") {
 		importRequest := git.GitImportRequest{
@@ -194,15 +301,29 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 			Repository: createdRepo,
 		}
 
+		if initialization.password != "" {
+			// the credentials are passed to the import through a service connection which only lives for the import
+			endpoint, err := createGitImportServiceEndpoint(clients, initialization, *createdRepo.Name, projectID)
+			if err != nil {
+				return err
+			}
+			defer deleteGitImportServiceEndpoint(clients, endpoint.Id, projectID)
+			initialization.serviceConnectionID = endpoint.Id.String()
+		}
+
 		if initialization.serviceConnectionID != "" {
 			importRequest.Parameters.ServiceEndpointId = converter.UUID(initialization.serviceConnectionID)
 			importRequest.Parameters.DeleteServiceEndpointAfterImportIsDone = converter.Bool(false)
 		}
 
-		_, importErr := createImportRequest(clients, importRequest, projectID.String(), *createdRepo.Name)
+		createdImportRequest, importErr := createImportRequest(clients, importRequest, projectID.String(), *createdRepo.Name)
 		if importErr != nil {
 			return fmt.Errorf("Error import repository in Azure DevOps: %+v ", importErr)
 		}
+
+		if err := waitForImportRequest(clients, createdImportRequest, projectID.String(), *createdRepo.Name, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return err
+		}
 	}
 
 	if initialization != nil && strings.EqualFold(initialization.initType, string(RepoInitTypeValues.Clean)) {
@@ -219,6 +340,12 @@ func resourceGitRepositoryCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
+	if d.Get("disabled").(bool) {
+		if err := setGitRepositoryDisabled(clients, createdRepo.Id, projectID.String(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceGitRepositoryRead(d, m)
 }
 
@@ -260,7 +387,164 @@ func createImportRequest(clients *client.AggregatedClient, gitImportRequest git.
 	return clients.GitReposClient.CreateImportRequest(clients.Ctx, args)
 }
 
-func createGitRepository(clients *client.AggregatedClient, repoName *string, projectID *uuid.UUID, parentRepo *git.GitRepositoryRef) (*git.GitRepository, error) {
+// setRestoredGitRepositoryDefaultBranch sets the configured default branch on a restored repository, which keeps the
+// default branch it had when it was deleted.
+func setRestoredGitRepositoryDefaultBranch(d *schema.ResourceData, clients *client.AggregatedClient, restoredRepo *git.GitRepository, projectID *uuid.UUID) error {
+	defaultBranch, ok := d.GetOk("default_branch")
+	if !ok || defaultBranch.(string) == converter.ToString(restoredRepo.DefaultBranch, "") {
+		return nil
+	}
+
+	repoID := restoredRepo.Id.String()
+	if err := checkRepositoryBranchExists(clients, repoID, defaultBranch.(string)); err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return fmt.Errorf("Default branch %s does not exist in restored repository %s", defaultBranch.(string), repoID)
+		}
+		return fmt.Errorf("Error looking up default branch %s of repository %s: %+v", defaultBranch.(string), repoID, err)
+	}
+
+	_, err := updateGitRepository(clients, &git.GitRepository{
+		Id:            restoredRepo.Id,
+		Name:          restoredRepo.Name,
+		DefaultBranch: converter.String(defaultBranch.(string)),
+	}, projectID)
+	if err != nil {
+		return fmt.Errorf("Error setting default branch %s of restored repository %s: %+v", defaultBranch.(string), repoID, err)
+	}
+	return nil
+}
+
+// restoreGitRepositoryFromRecycleBin restores the most recently deleted repository with the name from the recycle bin
+// of the project. Nil is returned if the recycle bin does not contain such a repository.
+func restoreGitRepositoryFromRecycleBin(clients *client.AggregatedClient, repoName string, projectID *uuid.UUID) (*git.GitRepository, error) {
+	deletedRepos, err := clients.GitReposClient.GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{
+		Project: converter.String(projectID.String()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the recycle bin of project %s: %+v", projectID.String(), err)
+	}
+	if deletedRepos == nil {
+		return nil, nil
+	}
+
+	// the recycle bin may contain several repositories with the name, the most recently deleted one is restored
+	var latestRepo *git.GitDeletedRepository
+	for i, deletedRepo := range *deletedRepos {
+		if deletedRepo.Id == nil || !strings.EqualFold(converter.ToString(deletedRepo.Name, ""), repoName) {
+			continue
+		}
+		if latestRepo == nil || isDeletedAfter(&deletedRepo, latestRepo) {
+			latestRepo = &(*deletedRepos)[i]
+		}
+	}
+	if latestRepo == nil {
+		return nil, nil
+	}
+
+	restoredRepo, err := clients.GitReposClient.RestoreRepositoryFromRecycleBin(clients.Ctx, git.RestoreRepositoryFromRecycleBinArgs{
+		RepositoryDetails: &git.GitRecycleBinRepositoryDetails{Deleted: converter.Bool(false)},
+		Project:           converter.String(projectID.String()),
+		RepositoryId:      latestRepo.Id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error restoring repository %s from the recycle bin: %+v", repoName, err)
+	}
+	return restoredRepo, nil
+}
+
+func isDeletedAfter(repo *git.GitDeletedRepository, other *git.GitDeletedRepository) bool {
+	if repo.DeletedDate == nil {
+		return false
+	}
+	return other.DeletedDate == nil || repo.DeletedDate.Time.After(other.DeletedDate.Time)
+}
+
+// waitForImportRequest waits until the import request is completed. The detailed error message of a failed import is returned.
+func waitForImportRequest(clients *client.AggregatedClient, importRequest *git.GitImportRequest, project string, repositoryID string, timeout time.Duration) error {
+	if importRequest == nil || importRequest.ImportRequestId == nil {
+		return nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			string(git.GitAsyncOperationStatusValues.Queued),
+			string(git.GitAsyncOperationStatusValues.InProgress),
+		},
+		Target: []string{string(git.GitAsyncOperationStatusValues.Completed)},
+		Refresh: func() (interface{}, string, error) {
+			gotImportRequest, err := clients.GitReposClient.GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+				Project:         &project,
+				RepositoryId:    &repositoryID,
+				ImportRequestId: importRequest.ImportRequestId,
+			})
+			if err != nil {
+				return nil, "", fmt.Errorf("Error reading import request %d: %+v", *importRequest.ImportRequestId, err)
+			}
+			if gotImportRequest.Status == nil {
+				return gotImportRequest, string(git.GitAsyncOperationStatusValues.Queued), nil
+			}
+
+			status := *gotImportRequest.Status
+			if status == git.GitAsyncOperationStatusValues.Failed || status == git.GitAsyncOperationStatusValues.Abandoned {
+				message := "no details available"
+				if gotImportRequest.DetailedStatus != nil && gotImportRequest.DetailedStatus.ErrorMessage != nil {
+					message = *gotImportRequest.DetailedStatus.ErrorMessage
+				}
+				return nil, "", fmt.Errorf("Import of repository %s %s: %s", repositoryID, status, message)
+			}
+			return gotImportRequest, string(status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf("Error waiting for the import of repository %s: %+v", repositoryID, err)
+	}
+	return nil
+}
+
+// createGitImportServiceEndpoint creates a generic git service connection with the credentials of the import
+func createGitImportServiceEndpoint(clients *client.AggregatedClient, initialization *repoInitializationMeta, repoName string, projectID *uuid.UUID) (*serviceendpoint.ServiceEndpoint, error) {
+	name := fmt.Sprintf("terraform-import-%s-%s", repoName, uuid.New().String())
+	endpoint, err := clients.ServiceEndpointClient.CreateServiceEndpoint(clients.Ctx, serviceendpoint.CreateServiceEndpointArgs{
+		Endpoint: &serviceendpoint.ServiceEndpoint{
+			Name:  &name,
+			Owner: converter.String("library"),
+			Type:  converter.String("git"),
+			Url:   &initialization.sourceURL,
+			Authorization: &serviceendpoint.EndpointAuthorization{
+				Parameters: &map[string]string{
+					"username": initialization.username,
+					"password": initialization.password,
+				},
+				Scheme: converter.String("UsernamePassword"),
+			},
+			ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{{
+				ProjectReference: &serviceendpoint.ProjectReference{Id: projectID},
+				Name:             &name,
+			}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error creating service connection to import repository %s: %+v", repoName, err)
+	}
+	return endpoint, nil
+}
+
+// deleteGitImportServiceEndpoint deletes the service connection created for an import. Errors are only logged as
+// they must not fail the import.
+func deleteGitImportServiceEndpoint(clients *client.AggregatedClient, endpointID *uuid.UUID, projectID *uuid.UUID) {
+	err := clients.ServiceEndpointClient.DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: endpointID,
+		ProjectIds: &[]string{projectID.String()},
+	})
+	if err != nil {
+		log.Printf("[WARN] Failed to delete the import service connection %s: %+v", endpointID.String(), err)
+	}
+}
+
+func createGitRepository(clients *client.AggregatedClient, repoName *string, projectID *uuid.UUID, parentRepo *git.GitRepositoryRef, sourceRef *string) (*git.GitRepository, error) {
 	args := git.CreateRepositoryArgs{
 		GitRepositoryToCreate: &git.GitRepositoryCreateOptions{
 			Name: repoName,
@@ -269,6 +553,7 @@ func createGitRepository(clients *client.AggregatedClient, repoName *string, pro
 			},
 			ParentRepository: parentRepo,
 		},
+		SourceRef: sourceRef,
 	}
 	createdRepository, err := clients.GitReposClient.CreateRepository(clients.Ctx, args)
 	if err != nil {
@@ -337,7 +622,7 @@ func resourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return fmt.Errorf("Failed to flatten Git repository: %w", err)
 	}
-	return nil
+	return readGitRepositoryState(clients, d, repo)
 }
 
 func resourceGitRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
@@ -347,14 +632,110 @@ func resourceGitRepositoryUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error converting terraform data model to AzDO project reference: %+v", err)
 	}
 
+	// a disabled repository rejects all other changes, so it is enabled first and disabled last
+	disabled := d.Get("disabled").(bool)
+	if disabled && !d.HasChange("disabled") {
+		if d.HasChanges("name", "default_branch") {
+			return fmt.Errorf("Repository %s is disabled, enable it to change the name or the default branch", d.Id())
+		}
+		return resourceGitRepositoryRead(d, m)
+	}
+
+	if d.HasChange("disabled") && !disabled {
+		if err := setGitRepositoryDisabled(clients, repo.Id, projectID.String(), false); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("default_branch") {
+		if err := checkRepositoryBranchExists(clients, d.Id(), *repo.DefaultBranch); err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return fmt.Errorf("Default branch %s does not exist in repository %s", *repo.DefaultBranch, d.Id())
+			}
+			return fmt.Errorf("Error looking up default branch %s of repository %s: %+v", *repo.DefaultBranch, d.Id(), err)
+		}
+	}
+
+	// the repository is renamed in place, its ID and content are kept
 	_, err = updateGitRepository(clients, repo, projectID)
 	if err != nil {
 		return fmt.Errorf("Error updating repository in Azure DevOps: %+v", err)
 	}
 
+	if d.HasChange("disabled") && disabled {
+		if err := setGitRepositoryDisabled(clients, repo.Id, projectID.String(), true); err != nil {
+			return err
+		}
+	}
+
 	return resourceGitRepositoryRead(d, m)
 }
 
+func setGitRepositoryDisabled(clients *client.AggregatedClient, repoID *uuid.UUID, projectID string, disabled bool) error {
+	_, err := clients.GitReposClientExtras.UpdateRepository(clients.Ctx, gitextras.UpdateRepositoryArgs{
+		NewRepositoryInfo: &gitextras.GitRepository{
+			IsDisabled: converter.Bool(disabled),
+		},
+		RepositoryId: repoID,
+		Project:      converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error setting disabled to %t for repository %s: %+v", disabled, repoID.String(), err)
+	}
+	return nil
+}
+
+// readGitRepositoryState reads whether the repository is disabled and, if enabled, the repository statistics. The
+// content of a disabled repository cannot be read, so the statistics are cleared.
+func readGitRepositoryState(clients *client.AggregatedClient, d *schema.ResourceData, repo *git.GitRepository) error {
+	repoID := repo.Id.String()
+	projectID := repo.Project.Id.String()
+
+	extendedRepo, err := clients.GitReposClientExtras.GetRepository(clients.Ctx, gitextras.GetRepositoryArgs{
+		RepositoryId: converter.String(repoID),
+		Project:      converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up the state of repository %s: %+v", repoID, err)
+	}
+	disabled := converter.ToBool(extendedRepo.IsDisabled, false)
+	d.Set("disabled", disabled)
+
+	branchCount := 0
+	lastCommitDate := ""
+	defaultBranch := converter.ToString(repo.DefaultBranch, "")
+	if !disabled && d.Get("include_statistics").(bool) && defaultBranch != "" {
+		branches, err := getGitRepositoryRefs(clients, repoID, "heads/")
+		if err != nil {
+			return fmt.Errorf("Error looking up the branches of repository %s: %+v", repoID, err)
+		}
+		branchCount = len(branches)
+
+		commits, err := clients.GitReposClient.GetCommits(clients.Ctx, git.GetCommitsArgs{
+			RepositoryId: converter.String(repoID),
+			Project:      converter.String(projectID),
+			Top:          converter.Int(1),
+			SearchCriteria: &git.GitQueryCommitsCriteria{
+				ItemVersion: &git.GitVersionDescriptor{
+					Version:     converter.String(shortBranchName(defaultBranch)),
+					VersionType: &git.GitVersionTypeValues.Branch,
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error looking up the last commit of repository %s: %+v", repoID, err)
+		}
+		if commits != nil && len(*commits) > 0 {
+			if committer := (*commits)[0].Committer; committer != nil && committer.Date != nil {
+				lastCommitDate = committer.Date.Time.Format(time.RFC3339)
+			}
+		}
+	}
+	d.Set("branch_count", branchCount)
+	d.Set("last_commit_date", lastCommitDate)
+	return nil
+}
+
 func updateGitRepository(clients *client.AggregatedClient, repository *git.GitRepository, project fmt.Stringer) (*git.GitRepository, error) {
 	if nil == project {
 		return nil, fmt.Errorf("updateGitRepository: ID of project cannot be nil")
@@ -377,6 +758,13 @@ func resourceGitRepositoryDelete(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// deleted repositories are kept in the recycle bin of the project, which blocks the name until they are purged
+	if d.Get("purge_on_destroy").(bool) {
+		if err := purgeGitRepository(clients, repoID, d.Get("project_id").(string)); err != nil {
+			return err
+		}
+	}
+
 	d.SetId("")
 	return nil
 }
@@ -391,6 +779,21 @@ func deleteGitRepository(clients *client.AggregatedClient, repoID string) error
 	})
 }
 
+func purgeGitRepository(clients *client.AggregatedClient, repoID string, projectID string) error {
+	uuid, err := uuid.Parse(repoID)
+	if err != nil {
+		return fmt.Errorf("Invalid repositoryId UUID: %s", repoID)
+	}
+	err = clients.GitReposClient.DeleteRepositoryFromRecycleBin(clients.Ctx, git.DeleteRepositoryFromRecycleBinArgs{
+		Project:      &projectID,
+		RepositoryId: &uuid,
+	})
+	if err != nil {
+		return fmt.Errorf("Error purging repository %s from the recycle bin: %+v", repoID, err)
+	}
+	return nil
+}
+
 // Lookup an Azure Git Repository using the ID, or name if the ID is not set.
 func gitRepositoryRead(clients *client.AggregatedClient, repoID string, repoName string, projectID string) (*git.GitRepository, error) {
 	identifier := repoID
@@ -459,12 +862,26 @@ func expandGitRepository(d *schema.ResourceData) (*git.GitRepository, *repoIniti
 			sourceType:          initValues["source_type"].(string),
 			sourceURL:           initValues["source_url"].(string),
 			serviceConnectionID: initValues["service_connection_id"].(string),
+			username:            initValues["username"].(string),
+			password:            initValues["password"].(string),
+		}
+
+		// a personal access token is passed as the password, most services do not evaluate the username then
+		if pat := initValues["personal_access_token"].(string); pat != "" {
+			if initialization.username == "" {
+				initialization.username = "pat"
+			}
+			initialization.password = pat
+		} else if initialization.username != "" && initialization.password == "" {
+			return nil, nil, nil, fmt.Errorf(" 'initialization.username' requires 'initialization.password' or 'initialization.personal_access_token'")
 		}
 
 		if strings.EqualFold(initialization.initType, "clean") {
 			initialization.sourceType = ""
 			initialization.sourceURL = ""
 			initialization.serviceConnectionID = ""
+			initialization.username = ""
+			initialization.password = ""
 		}
 
 		if _, ok := d.GetOk("default_branch"); ok {
@@ -0,0 +1,473 @@
+package git
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceGitRepositoryDirectory schema and implementation for mirroring a local directory into a branch
+func ResourceGitRepositoryDirectory() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceGitRepositoryDirectoryCreate,
+		Read:          resourceGitRepositoryDirectoryRead,
+		Update:        resourceGitRepositoryDirectoryUpdate,
+		Delete:        resourceGitRepositoryDirectoryDelete,
+		CustomizeDiff: customizeGitRepositoryDirectoryDiff,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The repository ID",
+				ValidateFunc: validation.IsUUID,
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The branch name, defaults to \"refs/heads/master\"",
+				Default:     "refs/heads/master",
+			},
+			"source_directory": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The local directory to mirror",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"target_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Description:  "The path in the repository the directory is mirrored to, defaults to the repository root",
+				Default:      "/",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Glob patterns of the files to mirror, relative to the source directory. Defaults to all files",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Glob patterns of the files to skip, relative to the source directory",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting files",
+			},
+			"overwrite_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable overwriting existing files, defaults to \"false\"",
+				Default:     false,
+			},
+			"file_hashes": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The git object IDs of the managed files, by repository path",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"untracked_files": {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The files below the target path which are not managed by this resource",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+	}
+}
+
+// repositoryDirectoryFile is a local file which is mirrored into the repository
+type repositoryDirectoryFile struct {
+	content []byte
+	hash    string
+}
+
+func resourceGitRepositoryDirectoryCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	if err := checkRepositoryBranchExists(clients, repoId, branch); err != nil {
+		return err
+	}
+
+	localFiles, err := readRepositoryDirectoryFiles(d)
+	if err != nil {
+		return err
+	}
+	repoHashes, err := getRepositoryDirectoryHashes(clients, repoId, branch, d.Get("target_path").(string))
+	if err != nil {
+		return err
+	}
+
+	if !d.Get("overwrite_on_create").(bool) {
+		conflicts := []string{}
+		for filePath, file := range localFiles {
+			if hash, ok := repoHashes[filePath]; ok && hash != file.hash {
+				conflicts = append(conflicts, filePath)
+			}
+		}
+		if len(conflicts) > 0 {
+			sort.Strings(conflicts)
+			return fmt.Errorf("Refusing to overwrite existing files %v. Configure `overwrite_on_create` to `true` to override.", conflicts)
+		}
+	}
+
+	changes := getRepositoryDirectoryChanges(localFiles, repoHashes, nil)
+	if len(changes) > 0 {
+		message := getRepositoryFilesCommitMessage(d, "Add", len(changes))
+		if err := pushRepositoryFileChanges(clients, repoId, branch, message, changes, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return fmt.Errorf("Create repository directory failed, repositoryID: %s, branch: %s. Error:  %+v", repoId, branch, err)
+		}
+	}
+
+	managed := map[string]interface{}{}
+	for filePath, file := range localFiles {
+		managed[filePath] = file.hash
+	}
+	d.Set("file_hashes", managed)
+	d.SetId(fmt.Sprintf("%s:%s:%s", repoId, branch, d.Get("target_path").(string)))
+	return resourceGitRepositoryDirectoryRead(d, m)
+}
+
+func resourceGitRepositoryDirectoryRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	if err := checkRepositoryBranchExists(clients, repoId, branch); err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	repoHashes, err := getRepositoryDirectoryHashes(clients, repoId, branch, d.Get("target_path").(string))
+	if err != nil {
+		return err
+	}
+
+	// the hashes of the managed files are refreshed from the repository, so that edits made in the
+	// repository show up as a difference to the local files
+	managed := map[string]interface{}{}
+	for filePath := range d.Get("file_hashes").(map[string]interface{}) {
+		if hash, ok := repoHashes[filePath]; ok {
+			managed[filePath] = hash
+		}
+	}
+	untracked := []string{}
+	for filePath := range repoHashes {
+		if _, ok := managed[filePath]; !ok {
+			untracked = append(untracked, filePath)
+		}
+	}
+	sort.Strings(untracked)
+
+	if err := d.Set("file_hashes", managed); err != nil {
+		return fmt.Errorf("Error setting file_hashes: %+v", err)
+	}
+	if err := d.Set("untracked_files", untracked); err != nil {
+		return fmt.Errorf("Error setting untracked_files: %+v", err)
+	}
+	return nil
+}
+
+func resourceGitRepositoryDirectoryUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	if err := checkRepositoryBranchExists(clients, repoId, branch); err != nil {
+		return err
+	}
+
+	localFiles, err := readRepositoryDirectoryFiles(d)
+	if err != nil {
+		return err
+	}
+	repoHashes, err := getRepositoryDirectoryHashes(clients, repoId, branch, d.Get("target_path").(string))
+	if err != nil {
+		return err
+	}
+
+	oldHashes, _ := d.GetChange("file_hashes")
+	managed := managedRepositoryDirectoryPaths(oldHashes.(map[string]interface{}))
+	changes := getRepositoryDirectoryChanges(localFiles, repoHashes, managed)
+	if len(changes) > 0 {
+		message := getRepositoryFilesCommitMessage(d, "Update", len(changes))
+		if err := pushRepositoryFileChanges(clients, repoId, branch, message, changes, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return fmt.Errorf("Update repository directory failed, repositoryID: %s, branch: %s. Error:  %+v", repoId, branch, err)
+		}
+	}
+
+	newHashes := map[string]interface{}{}
+	for filePath, file := range localFiles {
+		newHashes[filePath] = file.hash
+	}
+	d.Set("file_hashes", newHashes)
+	return resourceGitRepositoryDirectoryRead(d, m)
+}
+
+func resourceGitRepositoryDirectoryDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	repoHashes, err := getRepositoryDirectoryHashes(clients, repoId, branch, d.Get("target_path").(string))
+	if err != nil {
+		return err
+	}
+
+	managed := managedRepositoryDirectoryPaths(d.Get("file_hashes").(map[string]interface{}))
+	changes := getRepositoryDirectoryChanges(map[string]repositoryDirectoryFile{}, repoHashes, managed)
+	if len(changes) > 0 {
+		message := getRepositoryFilesCommitMessage(d, "Delete", len(changes))
+		if err := pushRepositoryFileChanges(clients, repoId, branch, message, changes, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("Failed to destroy the repository directory, repository ID: %s, branch: %s. Error %+v ", repoId, branch, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// customizeGitRepositoryDirectoryDiff plans an update if a local file differs from the managed file in the repository
+func customizeGitRepositoryDirectoryDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("source_directory") || !d.NewValueKnown("include") || !d.NewValueKnown("exclude") {
+		return d.SetNewComputed("file_hashes")
+	}
+
+	localFiles, err := readRepositoryDirectoryFiles(d)
+	if err != nil {
+		return err
+	}
+
+	localHashes := map[string]interface{}{}
+	for filePath, file := range localFiles {
+		localHashes[filePath] = file.hash
+	}
+
+	currentHashes := d.Get("file_hashes").(map[string]interface{})
+	if len(currentHashes) != len(localHashes) {
+		return d.SetNew("file_hashes", localHashes)
+	}
+	for filePath, hash := range localHashes {
+		if currentHashes[filePath] != hash {
+			return d.SetNew("file_hashes", localHashes)
+		}
+	}
+	return nil
+}
+
+type resourceDataGetter interface {
+	Get(string) interface{}
+}
+
+// readRepositoryDirectoryFiles reads the local files matching the include and exclude patterns, by repository path
+func readRepositoryDirectoryFiles(d resourceDataGetter) (map[string]repositoryDirectoryFile, error) {
+	sourceDirectory := d.Get("source_directory").(string)
+	targetPath := d.Get("target_path").(string)
+	includes := tfhelper.ExpandStringList(d.Get("include").([]interface{}))
+	excludes := tfhelper.ExpandStringList(d.Get("exclude").([]interface{}))
+
+	files := map[string]repositoryDirectoryFile{}
+	err := filepath.WalkDir(sourceDirectory, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, filePath)
+		if err != nil {
+			return err
+		}
+		relativePath = filepath.ToSlash(relativePath)
+		if !matchesRepositoryDirectoryFilters(relativePath, includes, excludes) {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+		files[path.Join("/", targetPath, relativePath)] = repositoryDirectoryFile{
+			content: content,
+			hash:    gitBlobHash(content),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading source directory %s: %+v", sourceDirectory, err)
+	}
+	return files, nil
+}
+
+// getRepositoryDirectoryHashes returns the git object IDs of all files below the target path
+func getRepositoryDirectoryHashes(clients *client.AggregatedClient, repoId, branch, targetPath string) (map[string]string, error) {
+	items, err := clients.GitReposClient.GetItems(context.Background(), git.GetItemsArgs{
+		RepositoryId:   &repoId,
+		ScopePath:      converter.String(path.Join("/", targetPath)),
+		RecursionLevel: &git.VersionControlRecursionTypeValues.Full,
+		VersionDescriptor: &git.GitVersionDescriptor{
+			Version:     converter.String(shortBranchName(branch)),
+			VersionType: &git.GitVersionTypeValues.Branch,
+		},
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("Query repository items failed, repositoryID: %s, branch: %s, path: %s. Error:  %+v", repoId, branch, targetPath, err)
+	}
+
+	hashes := map[string]string{}
+	if items == nil {
+		return hashes, nil
+	}
+	for _, item := range *items {
+		if converter.ToBool(item.IsFolder, false) || item.Path == nil || item.ObjectId == nil {
+			continue
+		}
+		hashes[*item.Path] = *item.ObjectId
+	}
+	return hashes, nil
+}
+
+// getRepositoryDirectoryChanges returns the changes which turn the files in the repository into the local files.
+// Managed files which no longer exist locally are deleted, files with an unchanged hash are skipped.
+func getRepositoryDirectoryChanges(localFiles map[string]repositoryDirectoryFile, repoHashes map[string]string, managed []string) []interface{} {
+	localPaths := make([]string, 0, len(localFiles))
+	for filePath := range localFiles {
+		localPaths = append(localPaths, filePath)
+	}
+	sort.Strings(localPaths)
+
+	changes := []interface{}{}
+	for _, filePath := range localPaths {
+		file := localFiles[filePath]
+		changeType := git.VersionControlChangeTypeValues.Add
+		if hash, ok := repoHashes[filePath]; ok {
+			if hash == file.hash {
+				continue
+			}
+			changeType = git.VersionControlChangeTypeValues.Edit
+		}
+		content := base64.StdEncoding.EncodeToString(file.content)
+		changes = append(changes, newRepositoryFileChange(changeType, filePath, &content, git.ItemContentTypeValues.Base64Encoded))
+	}
+
+	sort.Strings(managed)
+	for _, filePath := range managed {
+		if _, ok := localFiles[filePath]; ok {
+			continue
+		}
+		if _, ok := repoHashes[filePath]; ok {
+			changes = append(changes, newRepositoryFileChange(git.VersionControlChangeTypeValues.Delete, filePath, nil, git.ItemContentTypeValues.Base64Encoded))
+		}
+	}
+	return changes
+}
+
+func matchesRepositoryDirectoryFilters(relativePath string, includes, excludes []string) bool {
+	included := len(includes) == 0
+	for _, pattern := range includes {
+		if matchGlob(pattern, relativePath) {
+			included = true
+			break
+		}
+	}
+	if !included {
+		return false
+	}
+	for _, pattern := range excludes {
+		if matchGlob(pattern, relativePath) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchGlob matches a slash separated path against a glob pattern. In addition to the
+// syntax of path.Match, a "**" segment matches any number of directories.
+func matchGlob(pattern, name string) bool {
+	return matchGlobSegments(strings.Split(strings.Trim(pattern, "/"), "/"), strings.Split(name, "/"))
+}
+
+func matchGlobSegments(patterns, names []string) bool {
+	if len(patterns) == 0 {
+		return len(names) == 0
+	}
+	if patterns[0] == "**" {
+		for i := 0; i <= len(names); i++ {
+			if matchGlobSegments(patterns[1:], names[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(names) == 0 {
+		return false
+	}
+	if matched, err := path.Match(patterns[0], names[0]); err != nil || !matched {
+		return false
+	}
+	return matchGlobSegments(patterns[1:], names[1:])
+}
+
+// gitBlobHash returns the object ID git assigns to a file with the given content
+func gitBlobHash(content []byte) string {
+	hash := sha1.New() //nolint:gosec
+	hash.Write([]byte(fmt.Sprintf("blob %d\x00", len(content))))
+	hash.Write(content)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func managedRepositoryDirectoryPaths(hashes map[string]interface{}) []string {
+	paths := make([]string, 0, len(hashes))
+	for filePath := range hashes {
+		paths = append(paths, filePath)
+	}
+	sort.Strings(paths)
+	return paths
+}
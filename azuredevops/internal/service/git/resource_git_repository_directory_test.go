@@ -0,0 +1,147 @@
+//go:build (all || git || resource_git_repository_directory) && (!exclude_git || !exclude_resource_git_repository_directory)
+// +build all git resource_git_repository_directory
+// +build !exclude_git !exclude_resource_git_repository_directory
+
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testRepositoryDirectoryRepoID = uuid.New().String()
+
+func writeRepositoryDirectoryTestFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		require.Nil(t, os.MkdirAll(filepath.Dir(filePath), 0755))
+		require.Nil(t, os.WriteFile(filePath, []byte(content), 0600))
+	}
+	return dir
+}
+
+func TestGitRepositoryDirectory_MatchGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		name    string
+		matched bool
+	}{
+		{"*.txt", "a.txt", true},
+		{"*.txt", "docs/a.txt", false},
+		{"**/*.txt", "a.txt", true},
+		{"**/*.txt", "docs/nested/a.txt", true},
+		{"docs/**", "docs/nested/a.txt", true},
+		{"docs/**", "src/a.txt", false},
+		{"docs/*/a.txt", "docs/nested/a.txt", true},
+		{"[", "a.txt", false},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.matched, matchGlob(test.pattern, test.name), "pattern %s, name %s", test.pattern, test.name)
+	}
+}
+
+func TestGitRepositoryDirectory_GitBlobHash(t *testing.T) {
+	// the object IDs git assigns to an empty file and to a file containing "hello\n"
+	require.Equal(t, "e69de29bb2d1d6434b8b29ae775ad8c2e48c5391", gitBlobHash([]byte{}))
+	require.Equal(t, "ce013625030ba8dba906f756967f9e9ca394464a", gitBlobHash([]byte("hello\n")))
+}
+
+func TestGitRepositoryDirectory_GetChanges(t *testing.T) {
+	dir := writeRepositoryDirectoryTestFiles(t, map[string]string{
+		"a.txt":          "a",
+		"b.txt":          "updated",
+		"docs/c.txt":     "c",
+		"docs/skip.tmp":  "skip",
+		"other/d.txt":    "d",
+		"docs/image.bin": "\x00\x01\x02",
+	})
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryDirectory().Schema, map[string]interface{}{
+		"repository_id":    testRepositoryDirectoryRepoID,
+		"source_directory": dir,
+		"target_path":      "/site",
+		"include":          []interface{}{"*.txt", "docs/**"},
+		"exclude":          []interface{}{"**/*.tmp"},
+	})
+
+	localFiles, err := readRepositoryDirectoryFiles(d)
+	require.Nil(t, err)
+	require.Len(t, localFiles, 4)
+
+	repoHashes := map[string]string{
+		"/site/a.txt":       gitBlobHash([]byte("a")),
+		"/site/b.txt":       gitBlobHash([]byte("b")),
+		"/site/removed.txt": gitBlobHash([]byte("removed")),
+		"/site/foreign.txt": gitBlobHash([]byte("foreign")),
+	}
+	changes := getRepositoryDirectoryChanges(localFiles, repoHashes, []string{"/site/a.txt", "/site/removed.txt"})
+
+	summary := map[string]string{}
+	for _, raw := range changes {
+		change := raw.(git.GitChange)
+		summary[*change.Item.(git.GitItem).Path] = string(*change.ChangeType)
+		if change.NewContent != nil {
+			require.Equal(t, git.ItemContentTypeValues.Base64Encoded, *change.NewContent.ContentType)
+		}
+	}
+	require.Equal(t, map[string]string{
+		"/site/b.txt":          string(git.VersionControlChangeTypeValues.Edit),
+		"/site/docs/c.txt":     string(git.VersionControlChangeTypeValues.Add),
+		"/site/docs/image.bin": string(git.VersionControlChangeTypeValues.Add),
+		"/site/removed.txt":    string(git.VersionControlChangeTypeValues.Delete),
+	}, summary)
+}
+
+func TestGitRepositoryDirectory_Read_DetectsDriftAndUntrackedFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetBranch(gomock.Any(), gomock.Any()).
+		Return(&git.GitBranchStats{}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetItems(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.GetItemsArgs) (*[]git.GitItem, error) {
+			require.Equal(t, "/site", *args.ScopePath)
+			require.Equal(t, git.VersionControlRecursionTypeValues.Full, *args.RecursionLevel)
+			return &[]git.GitItem{
+				{Path: converter.String("/site"), IsFolder: converter.Bool(true)},
+				{Path: converter.String("/site/a.txt"), ObjectId: converter.String("edited")},
+				{Path: converter.String("/site/foreign.txt"), ObjectId: converter.String("foreign")},
+			}, nil
+		}).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryDirectory().Schema, map[string]interface{}{
+		"repository_id":    testRepositoryDirectoryRepoID,
+		"source_directory": t.TempDir(),
+		"target_path":      "/site",
+	})
+	d.SetId("id")
+	d.Set("file_hashes", map[string]interface{}{
+		"/site/a.txt":    gitBlobHash([]byte("a")),
+		"/site/gone.txt": gitBlobHash([]byte("gone")),
+	})
+
+	err := resourceGitRepositoryDirectoryRead(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, map[string]interface{}{"/site/a.txt": "edited"}, d.Get("file_hashes"))
+	require.Equal(t, []interface{}{"/site/foreign.txt"}, d.Get("untracked_files").(*schema.Set).List())
+}
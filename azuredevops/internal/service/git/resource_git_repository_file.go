@@ -3,6 +3,7 @@ package git
 import (
 	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -86,10 +87,18 @@ func ResourceGitRepositoryFile() *schema.Resource {
 				Description: "Enable overwriting existing files, defaults to \"false\"",
 				Default:     false,
 			},
+			"pull_request": repositoryFilePullRequestSchema(),
+			"pull_request_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The ID of the last pull request opened for a change of the file",
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(1 * time.Minute),
+			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(5 * time.Second),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 	}
 }
@@ -142,6 +151,12 @@ func resourceGitRepositoryFileCreate(d *schema.ResourceData, m interface{}) erro
 			m := fmt.Sprintf("Add %s", file)
 			(*args.Push.Commits)[0].Comment = &m
 		}
+		if _, ok := d.GetOk("pull_request"); ok {
+			if err := createRepositoryFilePullRequest(d, clients, args, d.Timeout(schema.TimeoutCreate)); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		}
 
 		_, err = clients.GitReposClient.CreatePush(ctx, *args)
 		if err != nil {
@@ -171,18 +186,25 @@ func resourceGitRepositoryFileRead(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
+	readBranch, abandoned, err := getRepositoryFileReadBranch(d, clients, repoId)
+	if err != nil {
+		return err
+	}
+
 	// Get the repository item if it exists
-	repoItem, err := clients.GitReposClient.GetItem(ctx, git.GetItemArgs{
-		RepositoryId:   &repoId,
-		Path:           &file,
-		IncludeContent: converter.Bool(true),
-		VersionDescriptor: &git.GitVersionDescriptor{
-			Version:     converter.String(shortBranchName(branch)),
-			VersionType: &git.GitVersionTypeValues.Branch,
-		},
+	repoItem, err := getRepositoryFileItem(clients, repoId, file, &git.GitVersionDescriptor{
+		Version:     converter.String(shortBranchName(readBranch)),
+		VersionType: &git.GitVersionTypeValues.Branch,
 	})
 	if err != nil {
 		if utils.ResponseWasNotFound(err) {
+			// the file of an abandoned pull request never reached the branch. The resource is kept and the missing
+			// content is reported as drift, instead of silently opening a new pull request with the next apply.
+			if abandoned {
+				log.Printf("[WARN] Pull request %d of file %s was abandoned, the file does not exist on branch %s", d.Get("pull_request_id").(int), file, branch)
+				d.Set("content", "")
+				return nil
+			}
 			d.SetId("")
 			return nil
 		}
@@ -218,13 +240,29 @@ func resourceGitRepositoryFileUpdate(d *schema.ResourceData, m interface{}) erro
 		return err
 	}
 
+	// changes of the pull request settings only apply to the next change of the file
+	if _, ok := d.GetOk("pull_request"); ok && !d.HasChanges("content", "commit_message") {
+		return resourceGitRepositoryFileRead(d, m)
+	}
+
+	// the file of an abandoned pull request does not exist on the branch, so it is added again
+	changeType := git.VersionControlChangeTypeValues.Edit
+	if _, ok := d.GetOk("pull_request"); ok {
+		if err := checkRepositoryFileExists(clients, repoId, file, branch); err != nil {
+			if !utils.ResponseWasNotFound(err) {
+				return fmt.Errorf("Query repository item failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, file, err)
+			}
+			changeType = git.VersionControlChangeTypeValues.Add
+		}
+	}
+
 	// Need to retry creating the file as multiple updates could happen at the same time
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError { //nolint:staticcheck
+	err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError { //nolint:staticcheck
 		objectID, err := getLastCommitId(clients, repoId, branch)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
-		args, err := resourceGitRepositoryPushArgs(d, objectID, git.VersionControlChangeTypeValues.Edit)
+		args, err := resourceGitRepositoryPushArgs(d, objectID, changeType)
 		if err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -232,6 +270,12 @@ func resourceGitRepositoryFileUpdate(d *schema.ResourceData, m interface{}) erro
 			m := fmt.Sprintf("Update %s", file)
 			(*args.Push.Commits)[0].Comment = &m
 		}
+		if _, ok := d.GetOk("pull_request"); ok {
+			if err := createRepositoryFilePullRequest(d, clients, args, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		}
 
 		_, err = clients.GitReposClient.CreatePush(ctx, *args)
 		if err != nil {
@@ -258,7 +302,21 @@ func resourceGitRepositoryFileDelete(d *schema.ResourceData, m interface{}) erro
 	branch := d.Get("branch").(string)
 	message := fmt.Sprintf("Delete %s", file)
 
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError { //nolint:staticcheck
+	// a file whose pull request was never completed does not exist on the branch, only its pull request is abandoned
+	if _, ok := d.GetOk("pull_request"); ok {
+		if err := checkRepositoryFileExists(clients, repoId, file, branch); err != nil {
+			if !utils.ResponseWasNotFound(err) {
+				return fmt.Errorf("Query repository item failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, file, err)
+			}
+			if err := abandonRepositoryFilePullRequest(d, clients, repoId); err != nil {
+				return fmt.Errorf("Failed to destroy the repository file, repository ID: %s, branch: %s. file %s. Error %+v ", repoId, branch, file, err)
+			}
+			d.Set("pull_request_id", nil)
+			return nil
+		}
+	}
+
+	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError { //nolint:staticcheck
 		objectID, err := getLastCommitId(clients, repoId, branch)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -270,7 +328,7 @@ func resourceGitRepositoryFileDelete(d *schema.ResourceData, m interface{}) erro
 				Path: &file,
 			},
 		}
-		_, err = clients.GitReposClient.CreatePush(ctx, git.CreatePushArgs{
+		args := &git.CreatePushArgs{
 			RepositoryId: &repoId,
 			Push: &git.GitPush{
 				RefUpdates: &[]git.GitRefUpdate{
@@ -286,7 +344,15 @@ func resourceGitRepositoryFileDelete(d *schema.ResourceData, m interface{}) erro
 					},
 				},
 			},
-		})
+		}
+		if _, ok := d.GetOk("pull_request"); ok {
+			if err := createRepositoryFilePullRequest(d, clients, args, d.Timeout(schema.TimeoutDelete)); err != nil {
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		}
+
+		_, err = clients.GitReposClient.CreatePush(ctx, *args)
 		if err != nil {
 			if utils.ResponseContainsStatusMessage(err, "has already been updated by another client") {
 				return resource.RetryableError(err)
@@ -327,6 +393,18 @@ func checkRepositoryFileExists(c *client.AggregatedClient, repoId, file, branch
 	return nil
 }
 
+// getRepositoryFileItem returns the file including its content and content metadata at the version. Without a
+// version the file is read from the default branch.
+func getRepositoryFileItem(c *client.AggregatedClient, repoId, file string, version *git.GitVersionDescriptor) (*git.GitItem, error) {
+	return c.GitReposClient.GetItem(context.Background(), git.GetItemArgs{
+		RepositoryId:           &repoId,
+		Path:                   &file,
+		IncludeContent:         converter.Bool(true),
+		IncludeContentMetadata: converter.Bool(true),
+		VersionDescriptor:      version,
+	})
+}
+
 // getLastCommitId returns the last commit id in the given branhc and repository.
 func getLastCommitId(c *client.AggregatedClient, repoId, branch string) (string, error) {
 	ctx := context.Background()
@@ -0,0 +1,271 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// repositoryFilePullRequestSchema describes the pull request which is opened instead of pushing to the branch
+func repositoryFilePullRequestSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Commit the changes to a topic branch and open a pull request into the branch instead of pushing to it",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"title": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "The title of the pull request, defaults to the commit message",
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+				"description": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "The description of the pull request",
+				},
+				"reviewers": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the identities to add as reviewers",
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.IsUUID,
+					},
+				},
+				"work_item_ids": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: "The IDs of the work items to link to the pull request",
+					Elem: &schema.Schema{
+						Type:         schema.TypeInt,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+				},
+				"auto_complete": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Complete the pull request automatically once all policies have passed",
+				},
+				"merge_strategy": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+					Description: "The merge strategy used when the pull request is completed",
+					ValidateFunc: validation.StringInSlice([]string{
+						string(git.GitPullRequestMergeStrategyValues.NoFastForward),
+						string(git.GitPullRequestMergeStrategyValues.Squash),
+						string(git.GitPullRequestMergeStrategyValues.Rebase),
+						string(git.GitPullRequestMergeStrategyValues.RebaseMerge),
+					}, false),
+				},
+				"delete_source_branch": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     true,
+					Description: "Delete the topic branch once the pull request is completed",
+				},
+				"wait_for_completion": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Wait until the pull request is completed",
+				},
+			},
+		},
+	}
+}
+
+// createRepositoryFilePullRequest pushes the commit of the push arguments to a new topic branch, which is based on
+// the latest commit of the branch the arguments target, and opens a pull request from the topic branch into that branch
+func createRepositoryFilePullRequest(d *schema.ResourceData, clients *client.AggregatedClient, args *git.CreatePushArgs, timeout time.Duration) error {
+	ctx := context.Background()
+	pullRequest := d.Get("pull_request").([]interface{})[0].(map[string]interface{})
+
+	refUpdate := &(*args.Push.RefUpdates)[0]
+	targetBranch := *refUpdate.Name
+	baseCommitID := *refUpdate.OldObjectId
+	sourceBranch := fmt.Sprintf("refs/heads/terraform/%s", uuid.New().String())
+
+	refUpdate.Name = &sourceBranch
+	refUpdate.OldObjectId = converter.String("0000000000000000000000000000000000000000")
+	commit := &(*args.Push.Commits)[0]
+	commit.Parents = &[]string{baseCommitID}
+	if _, err := clients.GitReposClient.CreatePush(ctx, *args); err != nil {
+		return fmt.Errorf("Push to topic branch %s failed. Error: %+v", sourceBranch, err)
+	}
+
+	// the pull request of a previous change is superseded by the new one, once the new change is pushed
+	if err := abandonRepositoryFilePullRequest(d, clients, *args.RepositoryId); err != nil {
+		return err
+	}
+
+	completionOptions := &git.GitPullRequestCompletionOptions{
+		DeleteSourceBranch: converter.Bool(pullRequest["delete_source_branch"].(bool)),
+		MergeStrategy:      converter.ToPtr(git.GitPullRequestMergeStrategy(pullRequest["merge_strategy"].(string))),
+	}
+	title := pullRequest["title"].(string)
+	if title == "" {
+		title = converter.ToString(commit.Comment, fmt.Sprintf("Update %s", shortBranchName(targetBranch)))
+	}
+
+	reviewers := []git.IdentityRefWithVote{}
+	for _, id := range tfhelper.ExpandStringSet(pullRequest["reviewers"].(*schema.Set)) {
+		reviewers = append(reviewers, git.IdentityRefWithVote{Id: converter.String(id)})
+	}
+	workItems := []webapi.ResourceRef{}
+	for _, id := range pullRequest["work_item_ids"].(*schema.Set).List() {
+		workItems = append(workItems, webapi.ResourceRef{Id: converter.String(fmt.Sprintf("%d", id.(int)))})
+	}
+
+	createdPullRequest, err := clients.GitReposClient.CreatePullRequest(ctx, git.CreatePullRequestArgs{
+		RepositoryId: args.RepositoryId,
+		GitPullRequestToCreate: &git.GitPullRequest{
+			SourceRefName:     &sourceBranch,
+			TargetRefName:     &targetBranch,
+			Title:             &title,
+			Description:       converter.String(pullRequest["description"].(string)),
+			Reviewers:         &reviewers,
+			WorkItemRefs:      &workItems,
+			CompletionOptions: completionOptions,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Create pull request from %s into %s failed. Error: %+v", sourceBranch, targetBranch, err)
+	}
+	d.Set("pull_request_id", *createdPullRequest.PullRequestId)
+
+	// auto-complete can only be set by an update, on behalf of the identity which created the pull request
+	if pullRequest["auto_complete"].(bool) {
+		if createdPullRequest.CreatedBy == nil || createdPullRequest.CreatedBy.Id == nil {
+			return fmt.Errorf("Enable auto-complete of pull request %d failed. The creator of the pull request is unknown", *createdPullRequest.PullRequestId)
+		}
+		_, err := clients.GitReposClient.UpdatePullRequest(ctx, git.UpdatePullRequestArgs{
+			RepositoryId:  args.RepositoryId,
+			PullRequestId: createdPullRequest.PullRequestId,
+			GitPullRequestToUpdate: &git.GitPullRequest{
+				AutoCompleteSetBy: &webapi.IdentityRef{Id: createdPullRequest.CreatedBy.Id},
+				CompletionOptions: completionOptions,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Enable auto-complete of pull request %d failed. Error: %+v", *createdPullRequest.PullRequestId, err)
+		}
+	}
+
+	if pullRequest["wait_for_completion"].(bool) {
+		return waitForRepositoryFilePullRequest(clients, *args.RepositoryId, *createdPullRequest.PullRequestId, timeout)
+	}
+	return nil
+}
+
+// waitForRepositoryFilePullRequest waits until the pull request is completed. An abandoned pull request is an error.
+func waitForRepositoryFilePullRequest(clients *client.AggregatedClient, repoId string, pullRequestId int, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{string(git.PullRequestStatusValues.Active)},
+		Target:  []string{string(git.PullRequestStatusValues.Completed)},
+		Refresh: func() (interface{}, string, error) {
+			pullRequest, err := getRepositoryFilePullRequest(clients, repoId, pullRequestId)
+			if err != nil {
+				return nil, "", err
+			}
+			if pullRequest.Status == nil {
+				return pullRequest, string(git.PullRequestStatusValues.Active), nil
+			}
+			return pullRequest, string(*pullRequest.Status), nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+		Delay:      2 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(clients.Ctx); err != nil {
+		return fmt.Errorf(" waiting for completion of pull request %d. %v ", pullRequestId, err)
+	}
+	return nil
+}
+
+// abandonRepositoryFilePullRequest abandons the last pull request opened for a change of the file, if it is still active
+func abandonRepositoryFilePullRequest(d *schema.ResourceData, clients *client.AggregatedClient, repoId string) error {
+	pullRequestId, ok := d.GetOk("pull_request_id")
+	if !ok {
+		return nil
+	}
+
+	pullRequest, err := getRepositoryFilePullRequest(clients, repoId, pullRequestId.(int))
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Get pull request %d failed. Error: %+v", pullRequestId.(int), err)
+	}
+	if pullRequest.Status == nil || *pullRequest.Status != git.PullRequestStatusValues.Active {
+		return nil
+	}
+
+	_, err = clients.GitReposClient.UpdatePullRequest(context.Background(), git.UpdatePullRequestArgs{
+		RepositoryId:  &repoId,
+		PullRequestId: converter.Int(pullRequestId.(int)),
+		GitPullRequestToUpdate: &git.GitPullRequest{
+			Status: &git.PullRequestStatusValues.Abandoned,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Abandon pull request %d failed. Error: %+v", pullRequestId.(int), err)
+	}
+	return nil
+}
+
+// getRepositoryFileReadBranch returns the branch the file is read from. While the last pull request
+// is active the file is read from its topic branch, otherwise from the branch of the resource. Whether
+// the last pull request was abandoned is returned as well.
+func getRepositoryFileReadBranch(d *schema.ResourceData, clients *client.AggregatedClient, repoId string) (string, bool, error) {
+	branch := d.Get("branch").(string)
+	if _, ok := d.GetOk("pull_request"); !ok {
+		return branch, false, nil
+	}
+	pullRequestId, ok := d.GetOk("pull_request_id")
+	if !ok {
+		return branch, false, nil
+	}
+
+	pullRequest, err := getRepositoryFilePullRequest(clients, repoId, pullRequestId.(int))
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return branch, false, nil
+		}
+		return "", false, fmt.Errorf("Get pull request %d failed. Error: %+v", pullRequestId.(int), err)
+	}
+	if pullRequest.Status == nil {
+		return branch, false, nil
+	}
+	switch *pullRequest.Status {
+	case git.PullRequestStatusValues.Active:
+		if pullRequest.SourceRefName != nil {
+			return *pullRequest.SourceRefName, false, nil
+		}
+	case git.PullRequestStatusValues.Abandoned:
+		return branch, true, nil
+	}
+	return branch, false, nil
+}
+
+func getRepositoryFilePullRequest(clients *client.AggregatedClient, repoId string, pullRequestId int) (*git.GitPullRequest, error) {
+	return clients.GitReposClient.GetPullRequest(context.Background(), git.GetPullRequestArgs{
+		RepositoryId:  &repoId,
+		PullRequestId: &pullRequestId,
+	})
+}
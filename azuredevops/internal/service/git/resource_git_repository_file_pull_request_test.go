@@ -0,0 +1,286 @@
+//go:build (all || git || resource_git_repository_file) && (!exclude_git || !exclude_resource_git_repository_file)
+// +build all git resource_git_repository_file
+// +build !exclude_git !exclude_resource_git_repository_file
+
+package git
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testRepositoryFilePullRequestRepoID = uuid.New().String()
+
+// verifies that in pull request mode the change is pushed to a new topic branch and a pull request
+// with auto-complete is opened into the branch, and that the file is read from the topic branch
+func TestGitRepositoryFile_Create_OpensPullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reviewerID := uuid.New().String()
+	creatorID := uuid.New().String()
+	var sourceBranch string
+
+	reposClient.
+		EXPECT().
+		GetBranch(gomock.Any(), gomock.Any()).
+		Return(&git.GitBranchStats{}, nil).
+		Times(2)
+	reposClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetCommits(gomock.Any(), gomock.Any()).
+		Return(&[]git.GitCommitRef{{CommitId: converter.String("base")}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreatePush(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+			refUpdate := (*args.Push.RefUpdates)[0]
+			sourceBranch = *refUpdate.Name
+			require.True(t, strings.HasPrefix(sourceBranch, "refs/heads/terraform/"))
+			require.Equal(t, "0000000000000000000000000000000000000000", *refUpdate.OldObjectId)
+			require.Equal(t, []string{"base"}, *(*args.Push.Commits)[0].Parents)
+			return &git.GitPush{}, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		CreatePullRequest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreatePullRequestArgs) (*git.GitPullRequest, error) {
+			pullRequest := args.GitPullRequestToCreate
+			require.Equal(t, sourceBranch, *pullRequest.SourceRefName)
+			require.Equal(t, "refs/heads/master", *pullRequest.TargetRefName)
+			require.Equal(t, "Add README.md", *pullRequest.Title)
+			require.Equal(t, reviewerID, *(*pullRequest.Reviewers)[0].Id)
+			require.Equal(t, "42", *(*pullRequest.WorkItemRefs)[0].Id)
+			require.Equal(t, git.GitPullRequestMergeStrategyValues.Squash, *pullRequest.CompletionOptions.MergeStrategy)
+			return &git.GitPullRequest{
+				PullRequestId: converter.Int(7),
+				CreatedBy:     &webapi.IdentityRef{Id: &creatorID},
+			}, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdatePullRequest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.UpdatePullRequestArgs) (*git.GitPullRequest, error) {
+			require.Equal(t, 7, *args.PullRequestId)
+			require.Equal(t, creatorID, *args.GitPullRequestToUpdate.AutoCompleteSetBy.Id)
+			return &git.GitPullRequest{}, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetPullRequest(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ git.GetPullRequestArgs) (*git.GitPullRequest, error) {
+			return &git.GitPullRequest{Status: &git.PullRequestStatusValues.Active, SourceRefName: &sourceBranch}, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.GetItemArgs) (*git.GitItem, error) {
+			require.Equal(t, shortBranchName(sourceBranch), *args.VersionDescriptor.Version)
+			return &git.GitItem{Content: converter.String("readme"), CommitId: converter.String("topic")}, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetCommit(gomock.Any(), gomock.Any()).
+		Return(&git.GitCommit{Comment: converter.String("Add README.md")}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": testRepositoryFilePullRequestRepoID,
+		"file":          "README.md",
+		"content":       "readme",
+		"pull_request": []interface{}{map[string]interface{}{
+			"reviewers":      []interface{}{reviewerID},
+			"work_item_ids":  []interface{}{42},
+			"auto_complete":  true,
+			"merge_strategy": "squash",
+		}},
+	})
+	err := resourceGitRepositoryFileCreate(d, clients)
+	require.Nil(t, err)
+	require.Equal(t, 7, d.Get("pull_request_id"))
+	require.Equal(t, "readme", d.Get("content"))
+}
+
+// verifies that waiting for a pull request fails if the pull request is abandoned
+func TestGitRepositoryFile_WaitForPullRequest_FailsIfAbandoned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetPullRequest(gomock.Any(), git.GetPullRequestArgs{
+			RepositoryId:  &testRepositoryFilePullRequestRepoID,
+			PullRequestId: converter.Int(7),
+		}).
+		Return(&git.GitPullRequest{Status: &git.PullRequestStatusValues.Abandoned}, nil).
+		Times(1)
+
+	err := waitForRepositoryFilePullRequest(clients, testRepositoryFilePullRequestRepoID, 7, time.Minute)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "abandoned")
+}
+
+// verifies that the still active pull request of a previous change is abandoned, and a completed one is left alone
+func TestGitRepositoryFile_AbandonsActivePullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": testRepositoryFilePullRequestRepoID,
+		"file":          "README.md",
+		"content":       "readme",
+	})
+	d.Set("pull_request_id", 7)
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetPullRequest(gomock.Any(), gomock.Any()).
+			Return(&git.GitPullRequest{Status: &git.PullRequestStatusValues.Active}, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			UpdatePullRequest(gomock.Any(), git.UpdatePullRequestArgs{
+				RepositoryId:  &testRepositoryFilePullRequestRepoID,
+				PullRequestId: converter.Int(7),
+				GitPullRequestToUpdate: &git.GitPullRequest{
+					Status: &git.PullRequestStatusValues.Abandoned,
+				},
+			}).
+			Return(&git.GitPullRequest{}, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			GetPullRequest(gomock.Any(), gomock.Any()).
+			Return(&git.GitPullRequest{Status: &git.PullRequestStatusValues.Completed}, nil).
+			Times(1),
+	)
+
+	require.Nil(t, abandonRepositoryFilePullRequest(d, clients, testRepositoryFilePullRequestRepoID))
+	require.Nil(t, abandonRepositoryFilePullRequest(d, clients, testRepositoryFilePullRequestRepoID))
+}
+
+// verifies that destroying a file whose pull request is still active abandons the pull request instead of
+// pushing the deletion of a file which does not exist on the branch
+func TestGitRepositoryFile_Delete_AbandonsActivePullRequestOfMissingFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": testRepositoryFilePullRequestRepoID,
+		"file":          "README.md",
+		"content":       "readme",
+		"pull_request":  []interface{}{map[string]interface{}{}},
+	})
+	d.SetId(testRepositoryFilePullRequestRepoID + "/README.md")
+	d.Set("pull_request_id", 7)
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetItem(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, args git.GetItemArgs) (*git.GitItem, error) {
+				require.Equal(t, "master", *args.VersionDescriptor.Version)
+				return nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}
+			}).
+			Times(1),
+		reposClient.
+			EXPECT().
+			GetPullRequest(gomock.Any(), gomock.Any()).
+			Return(&git.GitPullRequest{Status: &git.PullRequestStatusValues.Active}, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			UpdatePullRequest(gomock.Any(), git.UpdatePullRequestArgs{
+				RepositoryId:  &testRepositoryFilePullRequestRepoID,
+				PullRequestId: converter.Int(7),
+				GitPullRequestToUpdate: &git.GitPullRequest{
+					Status: &git.PullRequestStatusValues.Abandoned,
+				},
+			}).
+			Return(&git.GitPullRequest{}, nil).
+			Times(1),
+	)
+	reposClient.EXPECT().CreatePush(gomock.Any(), gomock.Any()).Times(0)
+
+	require.Nil(t, resourceGitRepositoryFileDelete(d, clients))
+	require.Equal(t, 0, d.Get("pull_request_id"))
+}
+
+// verifies that a file whose pull request was abandoned is kept in the state and its missing content is reported as drift
+func TestGitRepositoryFile_Read_KeepsFileOfAbandonedPullRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryFile().Schema, map[string]interface{}{
+		"repository_id": testRepositoryFilePullRequestRepoID,
+		"file":          "README.md",
+		"content":       "readme",
+		"pull_request":  []interface{}{map[string]interface{}{}},
+	})
+	d.SetId(testRepositoryFilePullRequestRepoID + "/README.md")
+	d.Set("pull_request_id", 7)
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetBranch(gomock.Any(), gomock.Any()).
+			Return(&git.GitBranchStats{}, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			GetPullRequest(gomock.Any(), gomock.Any()).
+			Return(&git.GitPullRequest{Status: &git.PullRequestStatusValues.Abandoned}, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			GetItem(gomock.Any(), gomock.Any()).
+			Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(http.StatusNotFound)}).
+			Times(1),
+	)
+
+	require.Nil(t, resourceGitRepositoryFileRead(d, clients))
+	require.Equal(t, testRepositoryFilePullRequestRepoID+"/README.md", d.Id())
+	require.Equal(t, "", d.Get("content"))
+	require.Equal(t, 7, d.Get("pull_request_id"))
+}
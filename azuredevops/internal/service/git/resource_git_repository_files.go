@@ -0,0 +1,329 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceGitRepositoryFiles schema and implementation for managing a set of files in a single commit
+func ResourceGitRepositoryFiles() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGitRepositoryFilesCreate,
+		Read:   resourceGitRepositoryFilesRead,
+		Update: resourceGitRepositoryFilesUpdate,
+		Delete: resourceGitRepositoryFilesDelete,
+		Schema: map[string]*schema.Schema{
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The repository ID",
+				ValidateFunc: validation.IsUUID,
+			},
+			"branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The branch name, defaults to \"refs/heads/master\"",
+				Default:     "refs/heads/master",
+			},
+			"file": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The files to manage",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The file path to manage",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"content": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The file's content",
+						},
+					},
+				},
+			},
+			"commit_message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The commit message when creating, updating or deleting the files",
+			},
+			"overwrite_on_create": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Enable overwriting existing files, defaults to \"false\"",
+				Default:     false,
+			},
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(2 * time.Minute),
+			Delete: schema.DefaultTimeout(2 * time.Minute),
+		},
+	}
+}
+
+func resourceGitRepositoryFilesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+	overwriteOnCreate := d.Get("overwrite_on_create").(bool)
+
+	if err := checkRepositoryBranchExists(clients, repoId, branch); err != nil {
+		return err
+	}
+
+	files := expandRepositoryFiles(d.Get("file").(*schema.Set))
+	existing, err := getExistingRepositoryFiles(clients, repoId, branch, sortedFilePaths(files))
+	if err != nil {
+		return err
+	}
+	if len(existing) > 0 && !overwriteOnCreate {
+		return fmt.Errorf("Refusing to overwrite existing files %v. Configure `overwrite_on_create` to `true` to override.", sortedFilePaths(existing))
+	}
+
+	changes := getRepositoryFileChanges(existing, files)
+	message := getRepositoryFilesCommitMessage(d, "Add", len(files))
+	if err := pushRepositoryFileChanges(clients, repoId, branch, message, changes, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Create repository files failed, repositoryID: %s, branch: %s. Error:  %+v", repoId, branch, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, branch))
+	return resourceGitRepositoryFilesRead(d, m)
+}
+
+func resourceGitRepositoryFilesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	if err := checkRepositoryBranchExists(clients, repoId, branch); err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	files := expandRepositoryFiles(d.Get("file").(*schema.Set))
+	existing, err := getExistingRepositoryFiles(clients, repoId, branch, sortedFilePaths(files))
+	if err != nil {
+		return err
+	}
+	if len(existing) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	return d.Set("file", flattenRepositoryFiles(existing))
+}
+
+func resourceGitRepositoryFilesUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	if err := checkRepositoryBranchExists(clients, repoId, branch); err != nil {
+		return err
+	}
+
+	if d.HasChange("file") {
+		oldFiles, newFiles := d.GetChange("file")
+		changes := getRepositoryFileChanges(
+			expandRepositoryFiles(oldFiles.(*schema.Set)),
+			expandRepositoryFiles(newFiles.(*schema.Set)),
+		)
+		if len(changes) > 0 {
+			message := getRepositoryFilesCommitMessage(d, "Update", len(changes))
+			if err := pushRepositoryFileChanges(clients, repoId, branch, message, changes, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return fmt.Errorf("Update repository files failed, repositoryID: %s, branch: %s. Error:  %+v", repoId, branch, err)
+			}
+		}
+	}
+
+	return resourceGitRepositoryFilesRead(d, m)
+}
+
+func resourceGitRepositoryFilesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	repoId := d.Get("repository_id").(string)
+	branch := d.Get("branch").(string)
+
+	files := expandRepositoryFiles(d.Get("file").(*schema.Set))
+	// only files which still exist can be deleted, otherwise the push is rejected
+	existing, err := getExistingRepositoryFiles(clients, repoId, branch, sortedFilePaths(files))
+	if err != nil {
+		return err
+	}
+
+	changes := getRepositoryFileChanges(existing, map[string]string{})
+	if len(changes) > 0 {
+		message := getRepositoryFilesCommitMessage(d, "Delete", len(changes))
+		if err := pushRepositoryFileChanges(clients, repoId, branch, message, changes, d.Timeout(schema.TimeoutDelete)); err != nil {
+			return fmt.Errorf("Failed to destroy the repository files, repository ID: %s, branch: %s. Error %+v ", repoId, branch, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// getExistingRepositoryFiles returns the content of the given paths which exist in the branch
+func getExistingRepositoryFiles(clients *client.AggregatedClient, repoId, branch string, paths []string) (map[string]string, error) {
+	ctx := context.Background()
+	existing := map[string]string{}
+	for _, path := range paths {
+		filePath := path
+		item, err := clients.GitReposClient.GetItem(ctx, git.GetItemArgs{
+			RepositoryId:   &repoId,
+			Path:           &filePath,
+			IncludeContent: converter.Bool(true),
+			VersionDescriptor: &git.GitVersionDescriptor{
+				Version:     converter.String(shortBranchName(branch)),
+				VersionType: &git.GitVersionTypeValues.Branch,
+			},
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				continue
+			}
+			return nil, fmt.Errorf("Query repository item failed, repositoryID: %s, branch: %s, file: %s . Error:  %+v", repoId, branch, path, err)
+		}
+		existing[path] = converter.ToString(item.Content, "")
+	}
+	return existing, nil
+}
+
+// getRepositoryFileChanges returns the changes which turn the old set of files into the new one. Files
+// missing from the new set are deleted, unchanged files are skipped.
+func getRepositoryFileChanges(oldFiles, newFiles map[string]string) []interface{} {
+	changes := []interface{}{}
+	for _, path := range sortedFilePaths(newFiles) {
+		content := newFiles[path]
+		changeType := git.VersionControlChangeTypeValues.Add
+		if oldContent, ok := oldFiles[path]; ok {
+			if oldContent == content {
+				continue
+			}
+			changeType = git.VersionControlChangeTypeValues.Edit
+		}
+		changes = append(changes, newRepositoryFileChange(changeType, path, &content, git.ItemContentTypeValues.RawText))
+	}
+	for _, path := range sortedFilePaths(oldFiles) {
+		if _, ok := newFiles[path]; !ok {
+			changes = append(changes, newRepositoryFileChange(git.VersionControlChangeTypeValues.Delete, path, nil, git.ItemContentTypeValues.RawText))
+		}
+	}
+	return changes
+}
+
+func newRepositoryFileChange(changeType git.VersionControlChangeType, path string, content *string, contentType git.ItemContentType) git.GitChange {
+	change := git.GitChange{
+		ChangeType: &changeType,
+		Item: git.GitItem{
+			Path: converter.String(path),
+		},
+	}
+	if content != nil {
+		change.NewContent = &git.ItemContent{
+			Content:     content,
+			ContentType: &contentType,
+		}
+	}
+	return change
+}
+
+// pushRepositoryFileChanges commits all changes in a single push. The push is retried with the
+// latest commit of the branch if the branch was updated by another client in the meantime.
+func pushRepositoryFileChanges(clients *client.AggregatedClient, repoId, branch, message string, changes []interface{}, timeout time.Duration) error {
+	ctx := context.Background()
+	return resource.Retry(timeout, func() *resource.RetryError { //nolint:staticcheck
+		objectID, err := getLastCommitId(clients, repoId, branch)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		_, err = clients.GitReposClient.CreatePush(ctx, git.CreatePushArgs{
+			RepositoryId: &repoId,
+			Push: &git.GitPush{
+				RefUpdates: &[]git.GitRefUpdate{
+					{
+						Name:        &branch,
+						OldObjectId: &objectID,
+					},
+				},
+				Commits: &[]git.GitCommitRef{
+					{
+						Comment: &message,
+						Changes: &changes,
+					},
+				},
+			},
+		})
+		if err != nil {
+			if utils.ResponseContainsStatusMessage(err, "has already been updated by another client") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+}
+
+func getRepositoryFilesCommitMessage(d *schema.ResourceData, action string, count int) string {
+	if message, ok := d.GetOk("commit_message"); ok {
+		return message.(string)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%s 1 file", action)
+	}
+	return fmt.Sprintf("%s %d files", action, count)
+}
+
+func expandRepositoryFiles(files *schema.Set) map[string]string {
+	result := map[string]string{}
+	for _, raw := range files.List() {
+		file := raw.(map[string]interface{})
+		result[file["path"].(string)] = file["content"].(string)
+	}
+	return result
+}
+
+func flattenRepositoryFiles(files map[string]string) []interface{} {
+	result := make([]interface{}, 0, len(files))
+	for _, path := range sortedFilePaths(files) {
+		result = append(result, map[string]interface{}{
+			"path":    path,
+			"content": files[path],
+		})
+	}
+	return result
+}
+
+func sortedFilePaths(files map[string]string) []string {
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
@@ -0,0 +1,125 @@
+//go:build (all || git || resource_git_repository_files) && (!exclude_git || !exclude_resource_git_repository_files)
+// +build all git resource_git_repository_files
+// +build !exclude_git !exclude_resource_git_repository_files
+
+package git
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testRepositoryFilesRepoID = uuid.New().String()
+
+func getRepositoryFilesResourceData(t *testing.T, overwrite bool, files map[string]string) *schema.ResourceData {
+	fileList := []interface{}{}
+	for path, content := range files {
+		fileList = append(fileList, map[string]interface{}{"path": path, "content": content})
+	}
+	return schema.TestResourceDataRaw(t, ResourceGitRepositoryFiles().Schema, map[string]interface{}{
+		"repository_id":       testRepositoryFilesRepoID,
+		"overwrite_on_create": overwrite,
+		"file":                fileList,
+	})
+}
+
+func getRepositoryFileChangeSummary(changes []interface{}) map[string]string {
+	summary := map[string]string{}
+	for _, raw := range changes {
+		change := raw.(git.GitChange)
+		summary[*change.Item.(git.GitItem).Path] = string(*change.ChangeType)
+	}
+	return summary
+}
+
+func TestGitRepositoryFiles_GetChanges(t *testing.T) {
+	changes := getRepositoryFileChanges(
+		map[string]string{"a.txt": "a", "b.txt": "b", "c.txt": "c"},
+		map[string]string{"a.txt": "a", "b.txt": "updated", "d.txt": "d"},
+	)
+
+	require.Equal(t, map[string]string{
+		"b.txt": string(git.VersionControlChangeTypeValues.Edit),
+		"c.txt": string(git.VersionControlChangeTypeValues.Delete),
+		"d.txt": string(git.VersionControlChangeTypeValues.Add),
+	}, getRepositoryFileChangeSummary(changes))
+	require.Nil(t, changes[2].(git.GitChange).NewContent)
+}
+
+func TestGitRepositoryFiles_Push_RetriesOnStaleObjectId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	branch := "refs/heads/master"
+	reposClient.
+		EXPECT().
+		GetCommits(gomock.Any(), gomock.Any()).
+		Return(&[]git.GitCommitRef{{CommitId: converter.String("stale")}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetCommits(gomock.Any(), gomock.Any()).
+		Return(&[]git.GitCommitRef{{CommitId: converter.String("latest")}}, nil).
+		Times(1)
+
+	var pushedObjectIDs []string
+	reposClient.
+		EXPECT().
+		CreatePush(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreatePushArgs) (*git.GitPush, error) {
+			pushedObjectIDs = append(pushedObjectIDs, *(*args.Push.RefUpdates)[0].OldObjectId)
+			require.Len(t, *(*args.Push.Commits)[0].Changes, 2)
+			if len(pushedObjectIDs) == 1 {
+				return nil, azuredevops.WrappedError{
+					Message:    converter.String("TF401028: The reference 'refs/heads/master' has already been updated by another client, so you cannot update it."),
+					StatusCode: converter.Int(http.StatusConflict),
+				}
+			}
+			return &git.GitPush{}, nil
+		}).
+		Times(2)
+
+	changes := getRepositoryFileChanges(map[string]string{}, map[string]string{"a.txt": "a", "b.txt": "b"})
+	err := pushRepositoryFileChanges(clients, testRepositoryFilesRepoID, branch, "Add 2 files", changes, time.Minute)
+	require.Nil(t, err)
+	require.Equal(t, []string{"stale", "latest"}, pushedObjectIDs)
+}
+
+func TestGitRepositoryFiles_Create_RefusesToOverwrite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetBranch(gomock.Any(), gomock.Any()).
+		Return(&git.GitBranchStats{}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetItem(gomock.Any(), gomock.Any()).
+		Return(&git.GitItem{Content: converter.String("existing")}, nil).
+		Times(1)
+
+	d := getRepositoryFilesResourceData(t, false, map[string]string{"a.txt": "a"})
+	err := resourceGitRepositoryFilesCreate(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Refusing to overwrite existing files [a.txt]")
+}
@@ -0,0 +1,298 @@
+package git
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceGitRepositoryTag schema to manage the lifecycle of a git repository tag
+func ResourceGitRepositoryTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositoryTagCreate,
+		ReadContext:   resourceGitRepositoryTagRead,
+		DeleteContext: resourceGitRepositoryTagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"ref_branch": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				ExactlyOneOf:     []string{"ref_branch", "ref_commit_id"},
+				DiffSuppressFunc: suppressGitRepositoryTagRefAfterImport,
+			},
+			"ref_commit_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				ExactlyOneOf:     []string{"ref_branch", "ref_commit_id"},
+				DiffSuppressFunc: suppressGitRepositoryTagRefAfterImport,
+			},
+			"annotated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"message": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"tagger_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"tagger_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"object_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"commit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceGitRepositoryTagCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	repoId := d.Get("repository_id").(string)
+
+	name := d.Get("name").(string)
+	shortTagName := withoutPrefix(REF_TAG_PREFIX, name)
+	longTagName := withPrefix(REF_TAG_PREFIX, name)
+	if name != shortTagName {
+		return diag.Errorf("Tag name must be in short format without refs/tags/ prefix, got: %q", name)
+	}
+
+	annotated := d.Get("annotated").(bool)
+	message := d.Get("message").(string)
+	if annotated && message == "" {
+		return diag.Errorf("A message is required to create the annotated tag %q", name)
+	}
+	if !annotated && (message != "" || d.Get("tagger_name").(string) != "" || d.Get("tagger_email").(string) != "") {
+		return diag.Errorf("message, tagger_name and tagger_email can only be set for annotated tags, tag: %q", name)
+	}
+
+	commitId := d.Get("ref_commit_id").(string)
+	if v, ok := d.GetOk("ref_branch"); ok {
+		gotBranch, err := clients.GitReposClient.GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(repoId),
+			Name:         converter.String(withoutPrefix(REF_BRANCH_PREFIX, v.(string))),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error getting latest commit of %q: %w", v.(string), err))
+		}
+		if gotBranch.Commit == nil || gotBranch.Commit.CommitId == nil {
+			return diag.Errorf("Branch %q has no commit to tag", v.(string))
+		}
+		commitId = *gotBranch.Commit.CommitId
+	}
+
+	if annotated {
+		projectId, err := getGitRepositoryProjectId(clients, repoId)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		tag := &git.GitAnnotatedTag{
+			Name:    converter.String(shortTagName),
+			Message: converter.String(message),
+			TaggedObject: &git.GitObject{
+				ObjectId: converter.String(commitId),
+			},
+		}
+		taggerName := d.Get("tagger_name").(string)
+		taggerEmail := d.Get("tagger_email").(string)
+		if taggerName != "" || taggerEmail != "" {
+			tag.TaggedBy = &git.GitUserDate{}
+			if taggerName != "" {
+				tag.TaggedBy.Name = converter.String(taggerName)
+			}
+			if taggerEmail != "" {
+				tag.TaggedBy.Email = converter.String(taggerEmail)
+			}
+		}
+
+		_, err = clients.GitReposClient.CreateAnnotatedTag(clients.Ctx, git.CreateAnnotatedTagArgs{
+			TagObject:    tag,
+			Project:      converter.String(projectId),
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error creating annotated tag %q: %w", shortTagName, err))
+		}
+	} else {
+		_, err := updateRefs(clients, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String(longTagName),
+				NewObjectId: converter.String(commitId),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: converter.String(repoId),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("Error creating tag %q: %w", shortTagName, err))
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, shortTagName))
+
+	return resourceGitRepositoryTagRead(ctx, d, m)
+}
+
+func resourceGitRepositoryTagRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, name, err := tfhelper.ParseGitRepoTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shortTagName := withoutPrefix(REF_TAG_PREFIX, name)
+	gotRef, err := getGitRepositoryTagRef(clients, repoId, shortTagName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if gotRef == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", repoId, shortTagName))
+	d.Set("name", shortTagName)
+	d.Set("repository_id", repoId)
+	d.Set("object_id", gotRef.ObjectId)
+
+	// only annotated tags point to a tag object which is peeled to the tagged commit
+	annotated := gotRef.PeeledObjectId != nil && *gotRef.PeeledObjectId != ""
+	d.Set("annotated", annotated)
+	if !annotated {
+		d.Set("commit_id", gotRef.ObjectId)
+		return nil
+	}
+	d.Set("commit_id", gotRef.PeeledObjectId)
+
+	projectId, err := getGitRepositoryProjectId(clients, repoId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tag, err := clients.GitReposClient.GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+		Project:      converter.String(projectId),
+		RepositoryId: converter.String(repoId),
+		ObjectId:     gotRef.ObjectId,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading annotated tag %q: %w", shortTagName, err))
+	}
+	d.Set("message", tag.Message)
+	if tag.TaggedBy != nil {
+		d.Set("tagger_name", tag.TaggedBy.Name)
+		d.Set("tagger_email", tag.TaggedBy.Email)
+	}
+
+	return nil
+}
+
+func resourceGitRepositoryTagDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	repoId, name, err := tfhelper.ParseGitRepoTagID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	shortTagName := withoutPrefix(REF_TAG_PREFIX, name)
+	gotRef, err := getGitRepositoryTagRef(clients, repoId, shortTagName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if gotRef == nil {
+		return nil
+	}
+
+	_, err = updateRefs(clients, git.UpdateRefsArgs{
+		RefUpdates: &[]git.GitRefUpdate{{
+			Name:        converter.String(withPrefix(REF_TAG_PREFIX, shortTagName)),
+			OldObjectId: gotRef.ObjectId,
+			NewObjectId: converter.String("0000000000000000000000000000000000000000"),
+		}},
+		RepositoryId: converter.String(repoId),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error deleting tag %q: %w", shortTagName, err))
+	}
+
+	return nil
+}
+
+// getGitRepositoryTagRef returns the ref of the tag, or nil if the tag does not exist
+func getGitRepositoryTagRef(clients *client.AggregatedClient, repoId, name string) (*git.GitRef, error) {
+	gotRefs, err := getGitRepositoryRefs(clients, repoId, "tags/"+name)
+	if err != nil {
+		return nil, err
+	}
+
+	// the filter matches by prefix, so other tags starting with the name may be returned as well
+	for i := range gotRefs {
+		if gotRefs[i].Name != nil && *gotRefs[i].Name == REF_TAG_PREFIX+name {
+			return &gotRefs[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// getGitRepositoryProjectId returns the ID of the project the repository belongs to
+func getGitRepositoryProjectId(clients *client.AggregatedClient, repoId string) (string, error) {
+	repo, err := clients.GitReposClient.GetRepository(clients.Ctx, git.GetRepositoryArgs{
+		RepositoryId: converter.String(repoId),
+	})
+	if err != nil {
+		return "", fmt.Errorf("Error reading repository %q: %w", repoId, err)
+	}
+	if repo.Project == nil || repo.Project.Id == nil {
+		return "", fmt.Errorf("Repository %q has no project", repoId)
+	}
+	return repo.Project.Id.String(), nil
+}
+
+// suppressGitRepositoryTagRefAfterImport ignores the ref of an imported tag. The ref is only used to create
+// the tag and cannot be read back.
+func suppressGitRepositoryTagRefAfterImport(_, old, _ string, d *schema.ResourceData) bool {
+	return old == "" && d.Id() != ""
+}
@@ -0,0 +1,213 @@
+//go:build (all || git || resource_git_repository_tag) && (!exclude_git || !exclude_resource_git_repository_tag)
+// +build all git resource_git_repository_tag
+// +build !exclude_git !exclude_resource_git_repository_tag
+
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testGitRepositoryTagRepoID = uuid.New().String()
+
+// verifies that a lightweight tag is created as a ref to the latest commit of the branch
+func TestGitRepositoryTag_Create_Lightweight(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: &testGitRepositoryTagRepoID,
+			Name:         converter.String("main"),
+		}).
+		Return(&git.GitBranchStats{Commit: &git.GitCommitRef{CommitId: converter.String("commit")}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+			RefUpdates: &[]git.GitRefUpdate{{
+				Name:        converter.String("refs/tags/v1"),
+				NewObjectId: converter.String("commit"),
+				OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			}},
+			RepositoryId: &testGitRepositoryTagRepoID,
+		}).
+		Return(&[]git.GitRefUpdateResult{{Success: converter.Bool(true)}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/tags/v1"), ObjectId: converter.String("commit")},
+		}}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, map[string]interface{}{
+		"repository_id": testGitRepositoryTagRepoID,
+		"name":          "v1",
+		"ref_branch":    "refs/heads/main",
+	})
+	diags := resourceGitRepositoryTagCreate(clients.Ctx, d, clients)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, testGitRepositoryTagRepoID+":v1", d.Id())
+	require.Equal(t, "commit", d.Get("commit_id"))
+	require.False(t, d.Get("annotated").(bool))
+}
+
+// verifies that an annotated tag is created in the project of the repository and read back with its message
+func TestGitRepositoryTag_Create_Annotated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	projectID := uuid.New()
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&git.GitRepository{Project: &core.TeamProjectReference{Id: &projectID}}, nil).
+		Times(2)
+	reposClient.
+		EXPECT().
+		CreateAnnotatedTag(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.CreateAnnotatedTagArgs) (*git.GitAnnotatedTag, error) {
+			require.Equal(t, projectID.String(), *args.Project)
+			require.Equal(t, "v1", *args.TagObject.Name)
+			require.Equal(t, "Release", *args.TagObject.Message)
+			require.Equal(t, "commit", *args.TagObject.TaggedObject.ObjectId)
+			require.Equal(t, "Bot", *args.TagObject.TaggedBy.Name)
+			require.Nil(t, args.TagObject.TaggedBy.Email)
+			return &git.GitAnnotatedTag{}, nil
+		}).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, gomock.Any()).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/tags/v1"), ObjectId: converter.String("tag"), PeeledObjectId: converter.String("commit")},
+		}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetAnnotatedTag(clients.Ctx, git.GetAnnotatedTagArgs{
+			Project:      converter.String(projectID.String()),
+			RepositoryId: &testGitRepositoryTagRepoID,
+			ObjectId:     converter.String("tag"),
+		}).
+		Return(&git.GitAnnotatedTag{
+			Message:  converter.String("Release"),
+			TaggedBy: &git.GitUserDate{Name: converter.String("Bot"), Email: converter.String("bot@example.com")},
+		}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, map[string]interface{}{
+		"repository_id": testGitRepositoryTagRepoID,
+		"name":          "v1",
+		"ref_commit_id": "commit",
+		"annotated":     true,
+		"message":       "Release",
+		"tagger_name":   "Bot",
+	})
+	diags := resourceGitRepositoryTagCreate(clients.Ctx, d, clients)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "tag", d.Get("object_id"))
+	require.Equal(t, "commit", d.Get("commit_id"))
+	require.Equal(t, "bot@example.com", d.Get("tagger_email"))
+}
+
+// verifies that an annotated tag cannot be created without a message
+func TestGitRepositoryTag_Create_AnnotatedRequiresMessage(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, map[string]interface{}{
+		"repository_id": testGitRepositoryTagRepoID,
+		"name":          "v1",
+		"ref_commit_id": "commit",
+		"annotated":     true,
+	})
+	diags := resourceGitRepositoryTagCreate(context.Background(), d, &client.AggregatedClient{})
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "A message is required")
+}
+
+// verifies that a tag is removed from the state if only tags sharing its prefix exist
+func TestGitRepositoryTag_Read_RemovesMissingTag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: &testGitRepositoryTagRepoID,
+			Filter:       converter.String("tags/v1"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{
+			{Name: converter.String("refs/tags/v1.1"), ObjectId: converter.String("commit")},
+		}}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceGitRepositoryTag().Schema, nil)
+	d.SetId(testGitRepositoryTagRepoID + ":v1")
+	diags := resourceGitRepositoryTagRead(clients.Ctx, d, clients)
+	require.False(t, diags.HasError(), diags)
+	require.Equal(t, "", d.Id())
+}
+
+// verifies that the tag is found if the refs sharing its prefix span multiple pages
+func TestGitRepositoryTag_Read_FindsTagOnLaterPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{GitReposClient: reposClient, Ctx: context.Background()}
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRefs(clients.Ctx, git.GetRefsArgs{
+				RepositoryId: &testGitRepositoryTagRepoID,
+				Filter:       converter.String("tags/v1"),
+				PeelTags:     converter.Bool(true),
+			}).
+			Return(&git.GetRefsResponseValue{
+				Value:             []git.GitRef{{Name: converter.String("refs/tags/v1.1"), ObjectId: converter.String("other")}},
+				ContinuationToken: "next",
+			}, nil).
+			Times(1),
+		reposClient.
+			EXPECT().
+			GetRefs(clients.Ctx, git.GetRefsArgs{
+				RepositoryId:      &testGitRepositoryTagRepoID,
+				Filter:            converter.String("tags/v1"),
+				PeelTags:          converter.Bool(true),
+				ContinuationToken: converter.String("next"),
+			}).
+			Return(&git.GetRefsResponseValue{
+				Value: []git.GitRef{{Name: converter.String("refs/tags/v1"), ObjectId: converter.String("commit")}},
+			}, nil).
+			Times(1),
+	)
+
+	gotRef, err := getGitRepositoryTagRef(clients, testGitRepositoryTagRepoID, "v1")
+	require.Nil(t, err)
+	require.NotNil(t, gotRef)
+	require.Equal(t, "commit", *gotRef.ObjectId)
+}
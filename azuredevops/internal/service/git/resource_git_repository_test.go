@@ -9,15 +9,19 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/gitextras"
 	"github.com/stretchr/testify/require"
 )
 
@@ -304,3 +308,496 @@ func TestGitRepo_Read_UsesNameIfIdNotSet(t *testing.T) {
 
 	resourceGitRepositoryRead(resourceData, clients)
 }
+
+// verifies that a repository is renamed before it is disabled, as a disabled repository rejects all other changes
+func TestGitRepo_Update_DisablesAfterRename(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	reposClientExtras := gitextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:       reposClient,
+		GitReposClientExtras: reposClientExtras,
+		Ctx:                  context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id":     testRepoProjectID.String(),
+		"name":           "NewName",
+		"disabled":       true,
+		"initialization": []interface{}{map[string]interface{}{"init_type": "Clean"}},
+	})
+	resourceData.SetId(testRepoID.String())
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			UpdateRepository(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args git.UpdateRepositoryArgs) (*git.GitRepository, error) {
+				require.Equal(t, "NewName", *args.NewRepositoryInfo.Name)
+				require.Equal(t, testRepoID, *args.NewRepositoryInfo.Id)
+				return &testGitRepository, nil
+			}),
+		reposClientExtras.
+			EXPECT().
+			UpdateRepository(clients.Ctx, gitextras.UpdateRepositoryArgs{
+				NewRepositoryInfo: &gitextras.GitRepository{IsDisabled: converter.Bool(true)},
+				RepositoryId:      &testRepoID,
+				Project:           converter.String(testRepoProjectID.String()),
+			}).
+			Return(&gitextras.GitRepository{}, nil),
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(&testGitRepository, nil),
+		reposClientExtras.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(&gitextras.GitRepository{IsDisabled: converter.Bool(true)}, nil),
+	)
+
+	err := resourceGitRepositoryUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get("disabled").(bool))
+}
+
+// verifies that the branch count and the date of the last commit of the default branch are read
+func TestGitRepo_Read_SetsRepositoryStatistics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	reposClientExtras := gitextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:       reposClient,
+		GitReposClientExtras: reposClientExtras,
+		Ctx:                  context.Background(),
+	}
+
+	repo := testGitRepository
+	repo.DefaultBranch = converter.String("refs/heads/main")
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&repo, nil).
+		Times(1)
+	reposClientExtras.
+		EXPECT().
+		GetRepository(clients.Ctx, gitextras.GetRepositoryArgs{
+			RepositoryId: converter.String(testRepoID.String()),
+			Project:      converter.String(testRepoProjectID.String()),
+		}).
+		Return(&gitextras.GitRepository{IsDisabled: converter.Bool(false)}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetRefs(clients.Ctx, git.GetRefsArgs{
+			RepositoryId: converter.String(testRepoID.String()),
+			Filter:       converter.String("heads/"),
+			PeelTags:     converter.Bool(true),
+		}).
+		Return(&git.GetRefsResponseValue{Value: []git.GitRef{{}, {}, {}}}, nil).
+		Times(1)
+	reposClient.
+		EXPECT().
+		GetCommits(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args git.GetCommitsArgs) (*[]git.GitCommitRef, error) {
+			require.Equal(t, "main", *args.SearchCriteria.ItemVersion.Version)
+			return &[]git.GitCommitRef{{
+				Committer: &git.GitUserDate{Date: &azuredevops.Time{Time: time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)}},
+			}}, nil
+		}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"include_statistics": true,
+	})
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+
+	err := resourceGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.False(t, resourceData.Get("disabled").(bool))
+	require.Equal(t, 3, resourceData.Get("branch_count"))
+	require.Equal(t, "2023-01-02T03:04:05Z", resourceData.Get("last_commit_date"))
+}
+
+// verifies that the statistics of a disabled repository are cleared, as its content cannot be read
+func TestGitRepo_Read_ClearsStatisticsOfDisabledRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	reposClientExtras := gitextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:       reposClient,
+		GitReposClientExtras: reposClientExtras,
+		Ctx:                  context.Background(),
+	}
+
+	repo := testGitRepository
+	repo.DefaultBranch = converter.String("refs/heads/main")
+	reposClient.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&repo, nil).
+		Times(1)
+	reposClientExtras.
+		EXPECT().
+		GetRepository(clients.Ctx, gomock.Any()).
+		Return(&gitextras.GitRepository{IsDisabled: converter.Bool(true)}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"include_statistics": true,
+	})
+	resourceData.SetId(testRepoID.String())
+	resourceData.Set("project_id", testRepoProjectID.String())
+	resourceData.Set("branch_count", 3)
+	resourceData.Set("last_commit_date", "2023-01-02T03:04:05Z")
+
+	err := resourceGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get("disabled").(bool))
+	require.Equal(t, 0, resourceData.Get("branch_count"))
+	require.Equal(t, "", resourceData.Get("last_commit_date"))
+}
+
+// verifies that a deleted repository with the same name is restored from the recycle bin
+// instead of creating a new repository, and that the restored repository is not initialized again
+func TestGitRepo_Create_RestoresFromRecycleBin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	reposClientExtras := gitextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:       reposClient,
+		GitReposClientExtras: reposClientExtras,
+		Ctx:                  context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id":               testRepoProjectID.String(),
+		"name":                     "reponame",
+		"restore_from_recycle_bin": true,
+		"initialization":           []interface{}{map[string]interface{}{"init_type": "Clean"}},
+	})
+
+	otherRepoID := uuid.New()
+	olderRepoID := uuid.New()
+	deletedDate := time.Now()
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRecycleBinRepositories(clients.Ctx, git.GetRecycleBinRepositoriesArgs{
+				Project: converter.String(testRepoProjectID.String()),
+			}).
+			Return(&[]git.GitDeletedRepository{
+				{Id: &otherRepoID, Name: converter.String("OtherRepo"), DeletedDate: &azuredevops.Time{Time: deletedDate}},
+				{Id: &testRepoID, Name: converter.String("RepoName"), DeletedDate: &azuredevops.Time{Time: deletedDate.Add(-time.Hour)}},
+				{Id: &olderRepoID, Name: converter.String("RepoName"), DeletedDate: &azuredevops.Time{Time: deletedDate.Add(-2 * time.Hour)}},
+			}, nil),
+		reposClient.
+			EXPECT().
+			RestoreRepositoryFromRecycleBin(clients.Ctx, git.RestoreRepositoryFromRecycleBinArgs{
+				RepositoryDetails: &git.GitRecycleBinRepositoryDetails{Deleted: converter.Bool(false)},
+				Project:           converter.String(testRepoProjectID.String()),
+				RepositoryId:      &testRepoID,
+			}).
+			Return(&testGitRepository, nil),
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(&testGitRepository, nil),
+		reposClientExtras.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(&gitextras.GitRepository{IsDisabled: converter.Bool(false)}, nil),
+	)
+
+	err := resourceGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+// verifies that the configured default branch is set on a restored repository
+func TestGitRepo_Create_SetsDefaultBranchOfRestoredRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	reposClientExtras := gitextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:       reposClient,
+		GitReposClientExtras: reposClientExtras,
+		Ctx:                  context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id":               testRepoProjectID.String(),
+		"name":                     "RepoName",
+		"default_branch":           "refs/heads/develop",
+		"restore_from_recycle_bin": true,
+		"initialization":           []interface{}{map[string]interface{}{"init_type": "Clean"}},
+	})
+
+	restoredRepo := testGitRepository
+	restoredRepo.DefaultBranch = converter.String("refs/heads/master")
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRecycleBinRepositories(clients.Ctx, gomock.Any()).
+			Return(&[]git.GitDeletedRepository{{Id: &testRepoID, Name: converter.String("RepoName")}}, nil),
+		reposClient.
+			EXPECT().
+			RestoreRepositoryFromRecycleBin(clients.Ctx, gomock.Any()).
+			Return(&restoredRepo, nil),
+		reposClient.
+			EXPECT().
+			GetBranch(gomock.Any(), git.GetBranchArgs{
+				RepositoryId: converter.String(testRepoID.String()),
+				Name:         converter.String("develop"),
+			}).
+			Return(&git.GitBranchStats{}, nil),
+		reposClient.
+			EXPECT().
+			UpdateRepository(clients.Ctx, git.UpdateRepositoryArgs{
+				NewRepositoryInfo: &git.GitRepository{
+					Id:            &testRepoID,
+					Name:          converter.String("RepoName"),
+					DefaultBranch: converter.String("refs/heads/develop"),
+				},
+				RepositoryId: &testRepoID,
+				Project:      converter.String(testRepoProjectID.String()),
+			}).
+			Return(&testGitRepository, nil),
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(&testGitRepository, nil),
+		reposClientExtras.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(&gitextras.GitRepository{IsDisabled: converter.Bool(false)}, nil),
+	)
+
+	err := resourceGitRepositoryCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+// verifies that a deleted repository is purged from the recycle bin if configured
+func TestGitRepo_Delete_PurgesFromRecycleBin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id":       testRepoProjectID.String(),
+		"name":             "RepoName",
+		"purge_on_destroy": true,
+		"initialization":   []interface{}{map[string]interface{}{"init_type": "Clean"}},
+	})
+	resourceData.SetId(testRepoID.String())
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			DeleteRepository(clients.Ctx, git.DeleteRepositoryArgs{RepositoryId: &testRepoID}).
+			Return(nil),
+		reposClient.
+			EXPECT().
+			DeleteRepositoryFromRecycleBin(clients.Ctx, git.DeleteRepositoryFromRecycleBinArgs{
+				Project:      converter.String(testRepoProjectID.String()),
+				RepositoryId: &testRepoID,
+			}).
+			Return(nil),
+	)
+
+	err := resourceGitRepositoryDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that an import with a personal access token passes the token through a temporary service
+// connection, which is deleted again, and that the detailed message of a failed import is returned
+func TestGitRepo_Create_ImportWithPersonalAccessTokenReturnsFailureMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient:        reposClient,
+		ServiceEndpointClient: serviceEndpointClient,
+		Ctx:                   context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id": testRepoProjectID.String(),
+		"name":       "RepoName",
+		"initialization": []interface{}{map[string]interface{}{
+			"init_type":             "Import",
+			"source_type":           "Git",
+			"source_url":            "https://example.com/source.git",
+			"personal_access_token": "secret",
+		}},
+	})
+
+	endpointID := uuid.New()
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			CreateRepository(clients.Ctx, gomock.Any()).
+			Return(&testGitRepository, nil),
+		serviceEndpointClient.
+			EXPECT().
+			CreateServiceEndpoint(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args serviceendpoint.CreateServiceEndpointArgs) (*serviceendpoint.ServiceEndpoint, error) {
+				require.Equal(t, "git", *args.Endpoint.Type)
+				require.Equal(t, "https://example.com/source.git", *args.Endpoint.Url)
+				require.Equal(t, "secret", (*args.Endpoint.Authorization.Parameters)["password"])
+				return &serviceendpoint.ServiceEndpoint{Id: &endpointID}, nil
+			}),
+		reposClient.
+			EXPECT().
+			CreateImportRequest(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args git.CreateImportRequestArgs) (*git.GitImportRequest, error) {
+				require.Equal(t, endpointID, *args.ImportRequest.Parameters.ServiceEndpointId)
+				return &git.GitImportRequest{ImportRequestId: converter.Int(3)}, nil
+			}),
+		reposClient.
+			EXPECT().
+			GetImportRequest(clients.Ctx, git.GetImportRequestArgs{
+				Project:         converter.String(testRepoProjectID.String()),
+				RepositoryId:    converter.String("RepoName"),
+				ImportRequestId: converter.Int(3),
+			}).
+			Return(&git.GitImportRequest{
+				Status:         &git.GitAsyncOperationStatusValues.Failed,
+				DetailedStatus: &git.GitImportStatusDetail{ErrorMessage: converter.String("Authentication failed")},
+			}, nil),
+		serviceEndpointClient.
+			EXPECT().
+			DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+				EndpointId: &endpointID,
+				ProjectIds: &[]string{testRepoProjectID.String()},
+			}).
+			Return(nil),
+	)
+
+	err := resourceGitRepositoryCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Authentication failed")
+	require.Equal(t, testRepoID.String(), resourceData.Id())
+}
+
+// verifies that a username is passed with a personal access token and is rejected without any secret
+func TestGitRepo_ExpandInitialization_UsernameWithPersonalAccessToken(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id": testRepoProjectID.String(),
+		"name":       "RepoName",
+		"initialization": []interface{}{map[string]interface{}{
+			"init_type":             "Import",
+			"source_type":           "Git",
+			"source_url":            "https://example.com/source.git",
+			"username":              "user",
+			"personal_access_token": "secret",
+		}},
+	})
+
+	_, initialization, _, err := expandGitRepository(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, "user", initialization.username)
+	require.Equal(t, "secret", initialization.password)
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id": testRepoProjectID.String(),
+		"name":       "RepoName",
+		"initialization": []interface{}{map[string]interface{}{
+			"init_type":   "Import",
+			"source_type": "Git",
+			"source_url":  "https://example.com/source.git",
+			"username":    "user",
+		}},
+	})
+
+	_, _, _, err = expandGitRepository(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "initialization.password")
+}
+
+// verifies that waiting for an import request succeeds once the import is completed
+func TestGitRepo_WaitForImportRequest_Completed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	reposClient.
+		EXPECT().
+		GetImportRequest(clients.Ctx, gomock.Any()).
+		Return(&git.GitImportRequest{Status: &git.GitAsyncOperationStatusValues.Completed}, nil).
+		Times(1)
+
+	importRequest := &git.GitImportRequest{ImportRequestId: converter.Int(3)}
+	err := waitForImportRequest(clients, importRequest, testRepoProjectID.String(), "RepoName", time.Minute)
+	require.Nil(t, err)
+}
+
+// verifies that only the default branch of the parent repository is forked if configured
+func TestGitRepo_Create_ForksOnlyDefaultBranch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	reposClient := azdosdkmocks.NewMockGitClient(ctrl)
+	clients := &client.AggregatedClient{
+		GitReposClient: reposClient,
+		Ctx:            context.Background(),
+	}
+
+	parentRepoID := uuid.New()
+	parentProjectID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepository().Schema, map[string]interface{}{
+		"project_id":           testRepoProjectID.String(),
+		"name":                 "RepoName",
+		"parent_repository_id": parentRepoID.String(),
+		"fork_options":         []interface{}{map[string]interface{}{"include_all_refs": false}},
+		"initialization":       []interface{}{map[string]interface{}{"init_type": "Clean"}},
+	})
+
+	gomock.InOrder(
+		reposClient.
+			EXPECT().
+			GetRepository(clients.Ctx, gomock.Any()).
+			Return(&git.GitRepository{
+				Id:            &parentRepoID,
+				Name:          converter.String("Parent"),
+				DefaultBranch: converter.String("refs/heads/main"),
+				Project:       &core.TeamProjectReference{Id: &parentProjectID},
+			}, nil),
+		reposClient.
+			EXPECT().
+			CreateRepository(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args git.CreateRepositoryArgs) (*git.GitRepository, error) {
+				require.Equal(t, "refs/heads/main", *args.SourceRef)
+				require.Equal(t, testRepoProjectID, *args.GitRepositoryToCreate.Project.Id)
+				require.Equal(t, parentProjectID, *args.GitRepositoryToCreate.ParentRepository.Project.Id)
+				return nil, errors.New("CreateRepository() Failed")
+			}),
+	)
+
+	err := resourceGitRepositoryCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateRepository() Failed")
+}
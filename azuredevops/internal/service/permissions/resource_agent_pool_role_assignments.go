@@ -0,0 +1,57 @@
+package permissions
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceAgentPoolRoleAssignments schema and implementation for agent pool role assignment resource
+func ResourceAgentPoolRoleAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentPoolRoleAssignmentsCreateOrUpdate,
+		Read:   resourceAgentPoolRoleAssignmentsRead,
+		Update: resourceAgentPoolRoleAssignmentsCreateOrUpdate,
+		Delete: resourceAgentPoolRoleAssignmentsDelete,
+		Schema: securityhelper.CreateRoleAssignmentResourceSchema(map[string]*schema.Schema{
+			"agent_pool_id": {
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+				Required:     true,
+				ForceNew:     true,
+			},
+		}, []string{"Reader", "Service Account", "Administrator"}),
+	}
+}
+
+func resourceAgentPoolRoleAssignmentsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := securityhelper.SetRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.AgentPool, createAgentPoolRoleResourceID); err != nil {
+		return err
+	}
+
+	return resourceAgentPoolRoleAssignmentsRead(d, m)
+}
+
+func resourceAgentPoolRoleAssignmentsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.ReadRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.AgentPool, createAgentPoolRoleResourceID)
+}
+
+func resourceAgentPoolRoleAssignmentsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.RemoveRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.AgentPool, createAgentPoolRoleResourceID)
+}
+
+func createAgentPoolRoleResourceID(d *schema.ResourceData) (string, error) {
+	agentPoolID, ok := d.GetOk("agent_pool_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'agent_pool_id' from schema")
+	}
+	return strconv.Itoa(agentPoolID.(int)), nil
+}
@@ -0,0 +1,66 @@
+package permissions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceAgentQueueRoleAssignments schema and implementation for agent queue role assignment resource
+func ResourceAgentQueueRoleAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAgentQueueRoleAssignmentsCreateOrUpdate,
+		Read:   resourceAgentQueueRoleAssignmentsRead,
+		Update: resourceAgentQueueRoleAssignmentsCreateOrUpdate,
+		Delete: resourceAgentQueueRoleAssignmentsDelete,
+		Schema: securityhelper.CreateRoleAssignmentResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"agent_queue_id": {
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+				Required:     true,
+				ForceNew:     true,
+			},
+		}, []string{"Reader", "User", "Creator", "Administrator"}),
+	}
+}
+
+func resourceAgentQueueRoleAssignmentsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := securityhelper.SetRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.AgentQueue, createAgentQueueRoleResourceID); err != nil {
+		return err
+	}
+
+	return resourceAgentQueueRoleAssignmentsRead(d, m)
+}
+
+func resourceAgentQueueRoleAssignmentsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.ReadRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.AgentQueue, createAgentQueueRoleResourceID)
+}
+
+func resourceAgentQueueRoleAssignmentsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.RemoveRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.AgentQueue, createAgentQueueRoleResourceID)
+}
+
+func createAgentQueueRoleResourceID(d *schema.ResourceData) (string, error) {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'project_id' from schema")
+	}
+	agentQueueID, ok := d.GetOk("agent_queue_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'agent_queue_id' from schema")
+	}
+	return fmt.Sprintf("%s_%d", projectID.(string), agentQueueID.(int)), nil
+}
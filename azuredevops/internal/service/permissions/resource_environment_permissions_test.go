@@ -0,0 +1,59 @@
+//go:build (all || permissions || resource_environment_permissions) && (!exclude_permissions || !resource_environment_permissions)
+// +build all permissions resource_environment_permissions
+// +build !exclude_permissions !resource_environment_permissions
+
+package permissions
+
+// The tests in this file use the mock clients in mock_client.go to mock out
+// the Azure DevOps client operations.
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var environmentProjectID = "9083e944-8e9e-405e-960a-c80180aa71e6"
+var environmentID = 5
+var environmentProjectToken = fmt.Sprintf("Environments/%s", environmentProjectID)
+var environmentToken = fmt.Sprintf("Environments/%s/%d", environmentProjectID, environmentID)
+
+func TestEnvironmentPermissions_CreateEnvironmentToken(t *testing.T) {
+	var d *schema.ResourceData
+	var token string
+	var err error
+
+	d = getEnvironmentPermissionsResource(t, environmentProjectID, environmentID)
+	token, err = createEnvironmentToken(d, nil)
+	assert.NotEmpty(t, token)
+	assert.Nil(t, err)
+	assert.Equal(t, environmentToken, token)
+
+	d = getEnvironmentPermissionsResource(t, environmentProjectID, 0)
+	token, err = createEnvironmentToken(d, nil)
+	assert.NotEmpty(t, token)
+	assert.Nil(t, err)
+	assert.Equal(t, environmentProjectToken, token)
+
+	d = getEnvironmentPermissionsResource(t, "", 0)
+	token, err = createEnvironmentToken(d, nil)
+	assert.Empty(t, token)
+	assert.NotNil(t, err)
+}
+
+func getEnvironmentPermissionsResource(t *testing.T, projectID string, environmentID int) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, ResourceEnvironmentPermissions().Schema, nil)
+	if projectID != "" {
+		d.Set("project_id", projectID)
+	}
+	if environmentID != 0 {
+		d.Set("environment_id", environmentID)
+	}
+	return d
+}
@@ -0,0 +1,66 @@
+package permissions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceEnvironmentRoleAssignments schema and implementation for environment role assignment resource
+func ResourceEnvironmentRoleAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentRoleAssignmentsCreateOrUpdate,
+		Read:   resourceEnvironmentRoleAssignmentsRead,
+		Update: resourceEnvironmentRoleAssignmentsCreateOrUpdate,
+		Delete: resourceEnvironmentRoleAssignmentsDelete,
+		Schema: securityhelper.CreateRoleAssignmentResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"environment_id": {
+				Type:         schema.TypeInt,
+				ValidateFunc: validation.IntAtLeast(1),
+				Required:     true,
+				ForceNew:     true,
+			},
+		}, []string{"Reader", "User", "Creator", "Administrator"}),
+	}
+}
+
+func resourceEnvironmentRoleAssignmentsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := securityhelper.SetRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.Environment, createEnvironmentRoleResourceID); err != nil {
+		return err
+	}
+
+	return resourceEnvironmentRoleAssignmentsRead(d, m)
+}
+
+func resourceEnvironmentRoleAssignmentsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.ReadRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.Environment, createEnvironmentRoleResourceID)
+}
+
+func resourceEnvironmentRoleAssignmentsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.RemoveRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.Environment, createEnvironmentRoleResourceID)
+}
+
+func createEnvironmentRoleResourceID(d *schema.ResourceData) (string, error) {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'project_id' from schema")
+	}
+	environmentID, ok := d.GetOk("environment_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'environment_id' from schema")
+	}
+	return fmt.Sprintf("%s_%d", projectID.(string), environmentID.(int)), nil
+}
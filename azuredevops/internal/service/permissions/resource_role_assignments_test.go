@@ -0,0 +1,77 @@
+//go:build (all || permissions || resource_role_assignments) && (!exclude_permissions || !resource_role_assignments)
+// +build all permissions resource_role_assignments
+// +build !exclude_permissions !resource_role_assignments
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+/**
+ * Begin unit tests
+ */
+
+var roleAssignmentsProjectID = "9083e944-8e9e-405e-960a-c80180aa71e6"
+var roleAssignmentsServiceEndpointID = "ee2a4d6d-3b55-4c43-9a5c-d4a0d5e4f3a1"
+
+func TestRoleAssignments_CreateResourceIDs(t *testing.T) {
+	tests := []struct {
+		name             string
+		resource         *schema.Resource
+		values           map[string]interface{}
+		createResourceID func(d *schema.ResourceData) (string, error)
+		expected         string
+	}{
+		{
+			name:             "agent pool",
+			resource:         ResourceAgentPoolRoleAssignments(),
+			values:           map[string]interface{}{"agent_pool_id": 10},
+			createResourceID: createAgentPoolRoleResourceID,
+			expected:         "10",
+		},
+		{
+			name:             "agent queue",
+			resource:         ResourceAgentQueueRoleAssignments(),
+			values:           map[string]interface{}{"project_id": roleAssignmentsProjectID, "agent_queue_id": 20},
+			createResourceID: createAgentQueueRoleResourceID,
+			expected:         roleAssignmentsProjectID + "_20",
+		},
+		{
+			name:             "environment",
+			resource:         ResourceEnvironmentRoleAssignments(),
+			values:           map[string]interface{}{"project_id": roleAssignmentsProjectID, "environment_id": 30},
+			createResourceID: createEnvironmentRoleResourceID,
+			expected:         roleAssignmentsProjectID + "_30",
+		},
+		{
+			name:             "variable group",
+			resource:         ResourceVariableGroupRoleAssignments(),
+			values:           map[string]interface{}{"project_id": roleAssignmentsProjectID, "variable_group_id": "40"},
+			createResourceID: createVariableGroupRoleResourceID,
+			expected:         roleAssignmentsProjectID + "$40",
+		},
+		{
+			name:             "service endpoint",
+			resource:         ResourceServiceEndpointRoleAssignments(),
+			values:           map[string]interface{}{"project_id": roleAssignmentsProjectID, "serviceendpoint_id": roleAssignmentsServiceEndpointID},
+			createResourceID: createServiceEndpointRoleResourceID,
+			expected:         roleAssignmentsProjectID + "_" + roleAssignmentsServiceEndpointID,
+		},
+	}
+
+	for _, test := range tests {
+		d := schema.TestResourceDataRaw(t, test.resource.Schema, test.values)
+		resourceID, err := test.createResourceID(d)
+		assert.Nil(t, err, test.name)
+		assert.Equal(t, test.expected, resourceID, test.name)
+
+		d = schema.TestResourceDataRaw(t, test.resource.Schema, nil)
+		resourceID, err = test.createResourceID(d)
+		assert.NotNil(t, err, test.name)
+		assert.Empty(t, resourceID, test.name)
+	}
+}
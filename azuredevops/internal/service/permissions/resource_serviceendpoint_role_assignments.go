@@ -0,0 +1,66 @@
+package permissions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceServiceEndpointRoleAssignments schema and implementation for service endpoint role assignment resource
+func ResourceServiceEndpointRoleAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceEndpointRoleAssignmentsCreateOrUpdate,
+		Read:   resourceServiceEndpointRoleAssignmentsRead,
+		Update: resourceServiceEndpointRoleAssignmentsCreateOrUpdate,
+		Delete: resourceServiceEndpointRoleAssignmentsDelete,
+		Schema: securityhelper.CreateRoleAssignmentResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"serviceendpoint_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+		}, []string{"Reader", "User", "Creator", "Administrator"}),
+	}
+}
+
+func resourceServiceEndpointRoleAssignmentsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := securityhelper.SetRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.ServiceEndpoint, createServiceEndpointRoleResourceID); err != nil {
+		return err
+	}
+
+	return resourceServiceEndpointRoleAssignmentsRead(d, m)
+}
+
+func resourceServiceEndpointRoleAssignmentsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.ReadRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.ServiceEndpoint, createServiceEndpointRoleResourceID)
+}
+
+func resourceServiceEndpointRoleAssignmentsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.RemoveRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.ServiceEndpoint, createServiceEndpointRoleResourceID)
+}
+
+func createServiceEndpointRoleResourceID(d *schema.ResourceData) (string, error) {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'project_id' from schema")
+	}
+	serviceEndpointID, ok := d.GetOk("serviceendpoint_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'serviceendpoint_id' from schema")
+	}
+	return fmt.Sprintf("%s_%s", projectID.(string), serviceEndpointID.(string)), nil
+}
@@ -0,0 +1,66 @@
+package permissions
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// ResourceVariableGroupRoleAssignments schema and implementation for variable group role assignment resource
+func ResourceVariableGroupRoleAssignments() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVariableGroupRoleAssignmentsCreateOrUpdate,
+		Read:   resourceVariableGroupRoleAssignmentsRead,
+		Update: resourceVariableGroupRoleAssignmentsCreateOrUpdate,
+		Delete: resourceVariableGroupRoleAssignmentsDelete,
+		Schema: securityhelper.CreateRoleAssignmentResourceSchema(map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"variable_group_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Required:     true,
+				ForceNew:     true,
+			},
+		}, []string{"Reader", "User", "Administrator"}),
+	}
+}
+
+func resourceVariableGroupRoleAssignmentsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := securityhelper.SetRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.VariableGroup, createVariableGroupRoleResourceID); err != nil {
+		return err
+	}
+
+	return resourceVariableGroupRoleAssignmentsRead(d, m)
+}
+
+func resourceVariableGroupRoleAssignmentsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.ReadRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.VariableGroup, createVariableGroupRoleResourceID)
+}
+
+func resourceVariableGroupRoleAssignmentsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return securityhelper.RemoveRoleAssignments(d, clients, securityhelper.RoleAssignmentScopeIDValues.VariableGroup, createVariableGroupRoleResourceID)
+}
+
+func createVariableGroupRoleResourceID(d *schema.ResourceData) (string, error) {
+	projectID, ok := d.GetOk("project_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'project_id' from schema")
+	}
+	variableGroupID, ok := d.GetOk("variable_group_id")
+	if !ok {
+		return "", fmt.Errorf("Failed to get 'variable_group_id' from schema")
+	}
+	return fmt.Sprintf("%s$%s", projectID.(string), variableGroupID.(string)), nil
+}
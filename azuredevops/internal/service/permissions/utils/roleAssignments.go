@@ -0,0 +1,355 @@
+package utils
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	azdoutils "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/securityroles"
+)
+
+// RoleAssignmentScopeID type for the security role scopes
+type RoleAssignmentScopeID string
+
+type roleAssignmentScopeIDValuesType struct {
+	AgentPool       RoleAssignmentScopeID
+	AgentQueue      RoleAssignmentScopeID
+	Environment     RoleAssignmentScopeID
+	VariableGroup   RoleAssignmentScopeID
+	ServiceEndpoint RoleAssignmentScopeID
+}
+
+// RoleAssignmentScopeIDValues the security role scopes supported by the role assignment resources
+var RoleAssignmentScopeIDValues = roleAssignmentScopeIDValuesType{
+	AgentPool:       "distributedtask.agentpoolrole",
+	AgentQueue:      "distributedtask.agentqueuerole",
+	Environment:     "distributedtask.environmentreferencerole",
+	VariableGroup:   "distributedtask.variablegroup",
+	ServiceEndpoint: "distributedtask.serviceendpointrole",
+}
+
+// RoleAssignmentResourceIDFunc builds the ID of the resource within a security role scope
+type RoleAssignmentResourceIDFunc func(d *schema.ResourceData) (string, error)
+
+// CreateRoleAssignmentResourceSchema creates a resource schema for a Terraform role assignment resource
+func CreateRoleAssignmentResourceSchema(outer map[string]*schema.Schema, roles []string) map[string]*schema.Schema {
+	baseSchema := map[string]*schema.Schema{
+		"inherit_permissions": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+		"assignment": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"principal": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"role_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(roles, false),
+					},
+				},
+			},
+		},
+	}
+
+	for key, elem := range baseSchema {
+		outer[key] = elem
+	}
+
+	return outer
+}
+
+// SetRoleAssignments reconciles the explicitly assigned roles of a resource with the configured
+// assignments. Explicit assignments which are not part of the configuration are removed.
+func SetRoleAssignments(d *schema.ResourceData, clients *client.AggregatedClient, scopeID RoleAssignmentScopeID, createResourceID RoleAssignmentResourceIDFunc) error {
+	resourceID, err := createResourceID(d)
+	if err != nil {
+		return err
+	}
+
+	desiredRoles, err := expandRoleAssignments(d, clients)
+	if err != nil {
+		return err
+	}
+
+	currentAssignments, err := clients.SecurityRolesClient.GetRoleAssignments(clients.Ctx, securityroles.GetRoleAssignmentsArgs{
+		ScopeId:    converter.String(string(scopeID)),
+		ResourceId: &resourceID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading role assignments of resource %s in scope %s: %+v", resourceID, scopeID, err)
+	}
+	currentRoles := getAssignedRoles(currentAssignments)
+
+	updates := []securityroles.UserRoleAssignmentRef{}
+	for _, identityID := range sortedIdentityIDs(desiredRoles) {
+		if roleName, ok := currentRoles[identityID]; ok && strings.EqualFold(roleName, desiredRoles[identityID]) {
+			continue
+		}
+		id := identityID
+		updates = append(updates, securityroles.UserRoleAssignmentRef{
+			UserId:   &id,
+			RoleName: converter.String(desiredRoles[identityID]),
+		})
+	}
+	removals := []uuid.UUID{}
+	for _, identityID := range sortedIdentityIDs(currentRoles) {
+		if _, ok := desiredRoles[identityID]; !ok {
+			removals = append(removals, identityID)
+		}
+	}
+
+	if len(updates) > 0 {
+		_, err := clients.SecurityRolesClient.SetRoleAssignments(clients.Ctx, securityroles.SetRoleAssignmentsArgs{
+			ScopeId:     converter.String(string(scopeID)),
+			ResourceId:  &resourceID,
+			Assignments: &updates,
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting role assignments of resource %s in scope %s: %+v", resourceID, scopeID, err)
+		}
+	}
+
+	if len(removals) > 0 {
+		err := clients.SecurityRolesClient.RemoveRoleAssignments(clients.Ctx, securityroles.RemoveRoleAssignmentsArgs{
+			ScopeId:     converter.String(string(scopeID)),
+			ResourceId:  &resourceID,
+			IdentityIds: &removals,
+		})
+		if err != nil {
+			return fmt.Errorf("Error removing role assignments of resource %s in scope %s: %+v", resourceID, scopeID, err)
+		}
+	}
+
+	inheritPermissions := d.Get("inherit_permissions").(bool)
+	if d.HasChange("inherit_permissions") || !inheritPermissions {
+		err := clients.SecurityRolesClient.SetRoleAssignmentInheritance(clients.Ctx, securityroles.SetRoleAssignmentInheritanceArgs{
+			ScopeId:            converter.String(string(scopeID)),
+			ResourceId:         &resourceID,
+			InheritPermissions: converter.Bool(inheritPermissions),
+		})
+		if err != nil {
+			return fmt.Errorf("Error setting role inheritance of resource %s in scope %s: %+v", resourceID, scopeID, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", scopeID, resourceID))
+	return nil
+}
+
+// hasInheritedRoleAssignments reports whether role assignments are inherited from the parent scope. The role
+// assignments API does not return the inheritance flag itself, inherited assignments are only returned while the
+// inheritance is enabled. Without inherited assignments the flag is unknown, as the parent scope may have none.
+func hasInheritedRoleAssignments(assignments *[]securityroles.RoleAssignment) bool {
+	if assignments == nil {
+		return false
+	}
+	for _, assignment := range *assignments {
+		if assignment.Access != nil && *assignment.Access == securityroles.RoleAccessValues.Inherited {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadRoleAssignments reads the explicitly assigned roles of a resource into the state
+func ReadRoleAssignments(d *schema.ResourceData, clients *client.AggregatedClient, scopeID RoleAssignmentScopeID, createResourceID RoleAssignmentResourceIDFunc) error {
+	resourceID, err := createResourceID(d)
+	if err != nil {
+		return err
+	}
+
+	assignments, err := clients.SecurityRolesClient.GetRoleAssignments(clients.Ctx, securityroles.GetRoleAssignmentsArgs{
+		ScopeId:    converter.String(string(scopeID)),
+		ResourceId: &resourceID,
+	})
+	if err != nil {
+		if azdoutils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading role assignments of resource %s in scope %s: %+v", resourceID, scopeID, err)
+	}
+
+	// the configured inheritance is kept unless inherited assignments prove it is enabled
+	if hasInheritedRoleAssignments(assignments) {
+		d.Set("inherit_permissions", true)
+	}
+
+	assignedRoles := getAssignedRoles(assignments)
+	descriptors, err := getSubjectDescriptors(clients, sortedIdentityIDs(assignedRoles))
+	if err != nil {
+		return err
+	}
+
+	result := make([]interface{}, 0, len(assignedRoles))
+	for identityID, roleName := range assignedRoles {
+		descriptor, ok := descriptors[identityID]
+		if !ok {
+			return fmt.Errorf("Failed to load identity information for identity [%s]", identityID.String())
+		}
+		result = append(result, map[string]interface{}{
+			"principal": descriptor,
+			"role_name": roleName,
+		})
+	}
+	return d.Set("assignment", result)
+}
+
+// RemoveRoleAssignments removes the role assignments managed by a resource and enables the inheritance again
+func RemoveRoleAssignments(d *schema.ResourceData, clients *client.AggregatedClient, scopeID RoleAssignmentScopeID, createResourceID RoleAssignmentResourceIDFunc) error {
+	resourceID, err := createResourceID(d)
+	if err != nil {
+		return err
+	}
+
+	roles, err := expandRoleAssignments(d, clients)
+	if err != nil {
+		return err
+	}
+
+	if len(roles) > 0 {
+		identityIDs := sortedIdentityIDs(roles)
+		err := clients.SecurityRolesClient.RemoveRoleAssignments(clients.Ctx, securityroles.RemoveRoleAssignmentsArgs{
+			ScopeId:     converter.String(string(scopeID)),
+			ResourceId:  &resourceID,
+			IdentityIds: &identityIDs,
+		})
+		if err != nil && !azdoutils.ResponseWasNotFound(err) {
+			return fmt.Errorf("Error removing role assignments of resource %s in scope %s: %+v", resourceID, scopeID, err)
+		}
+	}
+
+	if !d.Get("inherit_permissions").(bool) {
+		err := clients.SecurityRolesClient.SetRoleAssignmentInheritance(clients.Ctx, securityroles.SetRoleAssignmentInheritanceArgs{
+			ScopeId:            converter.String(string(scopeID)),
+			ResourceId:         &resourceID,
+			InheritPermissions: converter.Bool(true),
+		})
+		if err != nil && !azdoutils.ResponseWasNotFound(err) {
+			return fmt.Errorf("Error restoring role inheritance of resource %s in scope %s: %+v", resourceID, scopeID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// expandRoleAssignments maps the identity IDs of the configured principals to their role
+func expandRoleAssignments(d *schema.ResourceData, clients *client.AggregatedClient) (map[uuid.UUID]string, error) {
+	roles := map[uuid.UUID]string{}
+	assignments := d.Get("assignment").(*schema.Set).List()
+	if len(assignments) <= 0 {
+		return roles, nil
+	}
+
+	descriptors := make([]string, 0, len(assignments))
+	for _, assignment := range assignments {
+		descriptors = append(descriptors, assignment.(map[string]interface{})["principal"].(string))
+	}
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		SubjectDescriptors: converter.String(strings.Join(descriptors, ",")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading identities of principals [%s]: %+v", strings.Join(descriptors, ","), err)
+	}
+
+	identityIDs := map[string]uuid.UUID{}
+	if identities != nil {
+		for _, item := range *identities {
+			if item.SubjectDescriptor != nil && item.Id != nil {
+				identityIDs[*item.SubjectDescriptor] = *item.Id
+			}
+		}
+	}
+
+	for _, assignment := range assignments {
+		values := assignment.(map[string]interface{})
+		principal := values["principal"].(string)
+		identityID, ok := identityIDs[principal]
+		if !ok {
+			return nil, fmt.Errorf("Failed to load identity information for principal [%s]", principal)
+		}
+		if _, ok := roles[identityID]; ok {
+			return nil, fmt.Errorf("Principal [%s] is assigned more than one role", principal)
+		}
+		roles[identityID] = values["role_name"].(string)
+	}
+	return roles, nil
+}
+
+// getSubjectDescriptors maps identity IDs to the subject descriptors used as principals
+func getSubjectDescriptors(clients *client.AggregatedClient, identityIDs []uuid.UUID) (map[uuid.UUID]string, error) {
+	descriptors := map[uuid.UUID]string{}
+	if len(identityIDs) <= 0 {
+		return descriptors, nil
+	}
+
+	ids := make([]string, 0, len(identityIDs))
+	for _, identityID := range identityIDs {
+		ids = append(ids, identityID.String())
+	}
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		IdentityIds: converter.String(strings.Join(ids, ",")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error reading identities [%s]: %+v", strings.Join(ids, ","), err)
+	}
+
+	if identities != nil {
+		for _, item := range *identities {
+			if item.SubjectDescriptor != nil && item.Id != nil {
+				descriptors[*item.Id] = *item.SubjectDescriptor
+			}
+		}
+	}
+	return descriptors, nil
+}
+
+// getAssignedRoles maps the identity IDs of the explicit role assignments to their role
+func getAssignedRoles(assignments *[]securityroles.RoleAssignment) map[uuid.UUID]string {
+	roles := map[uuid.UUID]string{}
+	if assignments == nil {
+		return roles
+	}
+
+	for _, assignment := range *assignments {
+		if assignment.Access == nil || *assignment.Access != securityroles.RoleAccessValues.Assigned {
+			continue
+		}
+		if assignment.Identity == nil || assignment.Identity.Id == nil || assignment.Role == nil || assignment.Role.Name == nil {
+			continue
+		}
+		identityID, err := uuid.Parse(*assignment.Identity.Id)
+		if err != nil {
+			continue
+		}
+		roles[identityID] = *assignment.Role.Name
+	}
+	return roles
+}
+
+func sortedIdentityIDs(roles map[uuid.UUID]string) []uuid.UUID {
+	identityIDs := make([]uuid.UUID, 0, len(roles))
+	for identityID := range roles {
+		identityIDs = append(identityIDs, identityID)
+	}
+	sort.Slice(identityIDs, func(i, j int) bool {
+		return identityIDs[i].String() < identityIDs[j].String()
+	})
+	return identityIDs
+}
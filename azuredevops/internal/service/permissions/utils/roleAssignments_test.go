@@ -0,0 +1,273 @@
+//go:build (all || utils || roleassignments) && !exclude_roleassignments
+// +build all utils roleassignments
+// +build !exclude_roleassignments
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/securityroles"
+	"github.com/stretchr/testify/assert"
+)
+
+var roleAssignmentResourceID = "pool-1"
+
+var roleIdentityAdmins = uuid.MustParse("00000000-0000-0000-0000-00000000000a")
+var roleIdentityUsers = uuid.MustParse("00000000-0000-0000-0000-00000000000b")
+var roleIdentityReaders = uuid.MustParse("00000000-0000-0000-0000-00000000000c")
+var roleIdentityInherited = uuid.MustParse("00000000-0000-0000-0000-00000000000d")
+
+var roleIdentities = []identity.Identity{
+	{Id: &roleIdentityAdmins, SubjectDescriptor: converter.String("vssgp.admins")},
+	{Id: &roleIdentityUsers, SubjectDescriptor: converter.String("vssgp.users")},
+	{Id: &roleIdentityReaders, SubjectDescriptor: converter.String("vssgp.readers")},
+}
+
+func createRoleAssignmentResourceID(d *schema.ResourceData) (string, error) {
+	return roleAssignmentResourceID, nil
+}
+
+func getRoleAssignmentResourceData(t *testing.T, inherit bool, assignments map[string]string) *schema.ResourceData {
+	assignmentList := []interface{}{}
+	for principal, roleName := range assignments {
+		assignmentList = append(assignmentList, map[string]interface{}{
+			"principal": principal,
+			"role_name": roleName,
+		})
+	}
+	return schema.TestResourceDataRaw(t, CreateRoleAssignmentResourceSchema(map[string]*schema.Schema{}, []string{"Reader", "User", "Administrator"}), map[string]interface{}{
+		"inherit_permissions": inherit,
+		"assignment":          assignmentList,
+	})
+}
+
+func newRoleAssignment(identityID uuid.UUID, roleName string, access securityroles.RoleAccess) securityroles.RoleAssignment {
+	return securityroles.RoleAssignment{
+		Access:   &access,
+		Identity: &webapi.IdentityRef{Id: converter.String(identityID.String())},
+		Role:     &securityroles.SecurityRole{Name: converter.String(roleName)},
+	}
+}
+
+func TestRoleAssignments_Set_ReconcilesAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := securityroles.NewMockClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityRolesClient: rolesClient,
+		IdentityClient:      identityClient,
+		Ctx:                 context.Background(),
+	}
+
+	d := getRoleAssignmentResourceData(t, false, map[string]string{
+		"vssgp.admins": "Administrator",
+		"vssgp.users":  "User",
+	})
+	scopeID := converter.String(string(RoleAssignmentScopeIDValues.AgentPool))
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&roleIdentities, nil).
+		Times(1)
+	rolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, securityroles.GetRoleAssignmentsArgs{
+			ScopeId:    scopeID,
+			ResourceId: &roleAssignmentResourceID,
+		}).
+		Return(&[]securityroles.RoleAssignment{
+			newRoleAssignment(roleIdentityAdmins, "Reader", securityroles.RoleAccessValues.Assigned),
+			newRoleAssignment(roleIdentityReaders, "Reader", securityroles.RoleAccessValues.Assigned),
+			newRoleAssignment(roleIdentityInherited, "Administrator", securityroles.RoleAccessValues.Inherited),
+		}, nil).
+		Times(1)
+	rolesClient.
+		EXPECT().
+		SetRoleAssignments(clients.Ctx, securityroles.SetRoleAssignmentsArgs{
+			ScopeId:    scopeID,
+			ResourceId: &roleAssignmentResourceID,
+			Assignments: &[]securityroles.UserRoleAssignmentRef{
+				{UserId: &roleIdentityAdmins, RoleName: converter.String("Administrator")},
+				{UserId: &roleIdentityUsers, RoleName: converter.String("User")},
+			},
+		}).
+		Return(nil, nil).
+		Times(1)
+	rolesClient.
+		EXPECT().
+		RemoveRoleAssignments(clients.Ctx, securityroles.RemoveRoleAssignmentsArgs{
+			ScopeId:     scopeID,
+			ResourceId:  &roleAssignmentResourceID,
+			IdentityIds: &[]uuid.UUID{roleIdentityReaders},
+		}).
+		Return(nil).
+		Times(1)
+	rolesClient.
+		EXPECT().
+		SetRoleAssignmentInheritance(clients.Ctx, securityroles.SetRoleAssignmentInheritanceArgs{
+			ScopeId:            scopeID,
+			ResourceId:         &roleAssignmentResourceID,
+			InheritPermissions: converter.Bool(false),
+		}).
+		Return(nil).
+		Times(1)
+
+	err := SetRoleAssignments(d, clients, RoleAssignmentScopeIDValues.AgentPool, createRoleAssignmentResourceID)
+	assert.Nil(t, err)
+	assert.Equal(t, "distributedtask.agentpoolrole/pool-1", d.Id())
+}
+
+func TestRoleAssignments_Set_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := securityroles.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityRolesClient: rolesClient,
+		Ctx:                 context.Background(),
+	}
+
+	d := getRoleAssignmentResourceData(t, true, map[string]string{})
+	rolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetRoleAssignments() Failed")).
+		Times(1)
+
+	err := SetRoleAssignments(d, clients, RoleAssignmentScopeIDValues.AgentPool, createRoleAssignmentResourceID)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "GetRoleAssignments() Failed")
+}
+
+func TestRoleAssignments_Read_ReturnsExplicitAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := securityroles.NewMockClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityRolesClient: rolesClient,
+		IdentityClient:      identityClient,
+		Ctx:                 context.Background(),
+	}
+
+	d := getRoleAssignmentResourceData(t, false, map[string]string{})
+	rolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, gomock.Any()).
+		Return(&[]securityroles.RoleAssignment{
+			newRoleAssignment(roleIdentityAdmins, "Administrator", securityroles.RoleAccessValues.Assigned),
+			newRoleAssignment(roleIdentityInherited, "Reader", securityroles.RoleAccessValues.Inherited),
+		}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			IdentityIds: converter.String(roleIdentityAdmins.String()),
+		}).
+		Return(&[]identity.Identity{roleIdentities[0]}, nil).
+		Times(1)
+
+	err := ReadRoleAssignments(d, clients, RoleAssignmentScopeIDValues.AgentPool, createRoleAssignmentResourceID)
+	assert.Nil(t, err)
+	assert.True(t, d.Get("inherit_permissions").(bool))
+
+	assignments := d.Get("assignment").(*schema.Set).List()
+	assert.Len(t, assignments, 1)
+	assert.Equal(t, "vssgp.admins", assignments[0].(map[string]interface{})["principal"])
+	assert.Equal(t, "Administrator", assignments[0].(map[string]interface{})["role_name"])
+}
+
+func TestRoleAssignments_Read_KeepsInheritanceWithoutInheritedAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := securityroles.NewMockClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityRolesClient: rolesClient,
+		IdentityClient:      identityClient,
+		Ctx:                 context.Background(),
+	}
+
+	rolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, gomock.Any()).
+		Return(&[]securityroles.RoleAssignment{
+			newRoleAssignment(roleIdentityAdmins, "Administrator", securityroles.RoleAccessValues.Assigned),
+		}, nil).
+		Times(2)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&[]identity.Identity{roleIdentities[0]}, nil).
+		Times(2)
+
+	// the parent scope may have no assignments, so the inheritance cannot be read from the assignments
+	for _, inherit := range []bool{true, false} {
+		d := getRoleAssignmentResourceData(t, inherit, map[string]string{})
+		err := ReadRoleAssignments(d, clients, RoleAssignmentScopeIDValues.AgentPool, createRoleAssignmentResourceID)
+		assert.Nil(t, err)
+		assert.Equal(t, inherit, d.Get("inherit_permissions").(bool))
+	}
+}
+
+func TestRoleAssignments_Remove_RestoresInheritance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rolesClient := securityroles.NewMockClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityRolesClient: rolesClient,
+		IdentityClient:      identityClient,
+		Ctx:                 context.Background(),
+	}
+
+	d := getRoleAssignmentResourceData(t, false, map[string]string{
+		"vssgp.admins": "Administrator",
+	})
+	d.SetId("distributedtask.agentpoolrole/pool-1")
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, gomock.Any()).
+		Return(&roleIdentities, nil).
+		Times(1)
+	rolesClient.
+		EXPECT().
+		RemoveRoleAssignments(clients.Ctx, securityroles.RemoveRoleAssignmentsArgs{
+			ScopeId:     converter.String(string(RoleAssignmentScopeIDValues.AgentPool)),
+			ResourceId:  &roleAssignmentResourceID,
+			IdentityIds: &[]uuid.UUID{roleIdentityAdmins},
+		}).
+		Return(nil).
+		Times(1)
+	rolesClient.
+		EXPECT().
+		SetRoleAssignmentInheritance(clients.Ctx, securityroles.SetRoleAssignmentInheritanceArgs{
+			ScopeId:            converter.String(string(RoleAssignmentScopeIDValues.AgentPool)),
+			ResourceId:         &roleAssignmentResourceID,
+			InheritPermissions: converter.Bool(true),
+		}).
+		Return(nil).
+		Times(1)
+
+	err := RemoveRoleAssignments(d, clients, RoleAssignmentScopeIDValues.AgentPool, createRoleAssignmentResourceID)
+	assert.Nil(t, err)
+	assert.Empty(t, d.Id())
+}
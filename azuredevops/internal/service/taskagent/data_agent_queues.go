@@ -0,0 +1,114 @@
+package taskagent
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+)
+
+const (
+	aqAgentQueues = "agent_queues"
+	aqPoolType    = "pool_type"
+	aqIsHosted    = "hosted"
+)
+
+// DataAgentQueues schema and implementation for agent queues data source
+func DataAgentQueues() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAgentQueuesRead,
+		Schema: map[string]*schema.Schema{
+			projectID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.NoZeroValues,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			aqAgentQueues: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agentPoolID: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						aqPoolType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						aqIsHosted: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgentQueuesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	project := d.Get(projectID).(string)
+
+	agentQueues, err := clients.TaskAgentClient.GetAgentQueues(clients.Ctx, taskagent.GetAgentQueuesArgs{
+		Project: &project,
+	})
+	if err != nil {
+		return fmt.Errorf("Error finding agent queues in project %s. Error: %v", project, err)
+	}
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Read [%d] agent queues from project %s", len(*agentQueues), project)
+
+	if err := d.Set(aqAgentQueues, flattenAgentQueues(agentQueues)); err != nil {
+		return fmt.Errorf("Error setting agent_queues field in state. Error: %v", err)
+	}
+
+	d.SetId(project)
+	return nil
+}
+
+func flattenAgentQueues(agentQueues *[]taskagent.TaskAgentQueue) []interface{} {
+	results := make([]interface{}, 0)
+	if agentQueues == nil {
+		return results
+	}
+
+	for _, agentQueue := range *agentQueues {
+		if agentQueue.Id == nil {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"id":   *agentQueue.Id,
+			"name": converter.ToString(agentQueue.Name, ""),
+		}
+		if agentQueue.Pool != nil {
+			result[agentPoolID] = converter.ToInt(agentQueue.Pool.Id, 0)
+			result[aqIsHosted] = converter.ToBool(agentQueue.Pool.IsHosted, false)
+			if agentQueue.Pool.PoolType != nil {
+				result[aqPoolType] = string(*agentQueue.Pool.PoolType)
+			}
+		}
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].(map[string]interface{})["id"].(int) < results[j].(map[string]interface{})["id"].(int)
+	})
+	return results
+}
@@ -0,0 +1,100 @@
+//go:build (all || data_sources || data_agent_queues) && (!exclude_data_sources || !exclude_data_agent_queues)
+// +build all data_sources data_agent_queues
+// +build !exclude_data_sources !exclude_data_agent_queues
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testAgentQueuesProjectID = "project"
+
+var testAgentQueues = []taskagent.TaskAgentQueue{
+	{
+		Id:   converter.Int(20),
+		Name: converter.String("Shared Pool"),
+		Pool: &taskagent.TaskAgentPoolReference{
+			Id:       converter.Int(5),
+			PoolType: &taskagent.TaskAgentPoolTypeValues.Automation,
+			IsHosted: converter.Bool(false),
+		},
+	},
+	{
+		Id:   converter.Int(10),
+		Name: converter.String("Azure Pipelines"),
+		Pool: &taskagent.TaskAgentPoolReference{
+			Id:       converter.Int(1),
+			PoolType: &taskagent.TaskAgentPoolTypeValues.Automation,
+			IsHosted: converter.Bool(true),
+		},
+	},
+}
+
+// verifies that the queues are flattened with their pool, sorted by id
+func TestDataSourceAgentQueues_Flatten(t *testing.T) {
+	agentQueues := flattenAgentQueues(&testAgentQueues)
+	require.Len(t, agentQueues, 2)
+
+	first := agentQueues[0].(map[string]interface{})
+	require.Equal(t, 10, first["id"])
+	require.Equal(t, "Azure Pipelines", first["name"])
+	require.Equal(t, 1, first[agentPoolID])
+	require.Equal(t, "automation", first[aqPoolType])
+	require.Equal(t, true, first[aqIsHosted])
+	require.Equal(t, 20, agentQueues[1].(map[string]interface{})["id"])
+}
+
+// verifies that all queues of the project are read
+func TestDataSourceAgentQueues_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentQueues(clients.Ctx, taskagent.GetAgentQueuesArgs{Project: &testAgentQueuesProjectID}).
+		Return(&testAgentQueues, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgentQueues().Schema, map[string]interface{}{
+		projectID: testAgentQueuesProjectID,
+	})
+	err := dataSourceAgentQueuesRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testAgentQueuesProjectID, resourceData.Id())
+	require.Len(t, resourceData.Get(aqAgentQueues).([]interface{}), 2)
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestDataSourceAgentQueues_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentQueues(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetAgentQueues() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgentQueues().Schema, map[string]interface{}{
+		projectID: testAgentQueuesProjectID,
+	})
+	err := dataSourceAgentQueuesRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetAgentQueues() Failed")
+}
@@ -0,0 +1,261 @@
+package taskagent
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	agPoolID               = "pool_id"
+	agName                 = "name"
+	agDemands              = "demands"
+	agStatus               = "status"
+	agAgents               = "agents"
+	agVersion              = "version"
+	agEnabled              = "enabled"
+	agOsDescription        = "os_description"
+	agProvisioningState    = "provisioning_state"
+	agCreatedOn            = "created_on"
+	agStatusChangedOn      = "status_changed_on"
+	agSystemCapabilities   = "system_capabilities"
+	agUserCapabilities     = "user_capabilities"
+	agLastCompletedRequest = "last_completed_request"
+)
+
+// DataAgents schema and implementation for agents data source
+func DataAgents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAgentsRead,
+		Schema: map[string]*schema.Schema{
+			agPoolID: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			agName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			agDemands: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			agStatus: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(taskagent.TaskAgentStatusValues.Online),
+					string(taskagent.TaskAgentStatusValues.Offline),
+				}, false),
+			},
+			agAgents: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						agName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agEnabled: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						agOsDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agProvisioningState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agCreatedOn: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agStatusChangedOn: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						agSystemCapabilities: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						agUserCapabilities: {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						agLastCompletedRequest: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"request_id": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"job_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"definition_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"result": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"queue_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"finish_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgentsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	poolID := d.Get(agPoolID).(int)
+
+	args := taskagent.GetAgentsArgs{
+		PoolId:                      &poolID,
+		IncludeCapabilities:         converter.Bool(true),
+		IncludeLastCompletedRequest: converter.Bool(true),
+	}
+	if name, ok := d.GetOk(agName); ok {
+		args.AgentName = converter.String(name.(string))
+	}
+	if demands, ok := d.GetOk(agDemands); ok {
+		demandList := tfhelper.ExpandStringList(demands.([]interface{}))
+		args.Demands = &demandList
+	}
+
+	agents, err := clients.TaskAgentClient.GetAgents(clients.Ctx, args)
+	if err != nil {
+		return fmt.Errorf("Error finding agents in agent pool %d. Error: %v", poolID, err)
+	}
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Read [%d] agents from agent pool %d", len(*agents), poolID)
+
+	if err := d.Set(agAgents, flattenAgents(agents, d.Get(agStatus).(string))); err != nil {
+		return fmt.Errorf("Error setting agents field in state. Error: %v", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+func flattenAgents(agents *[]taskagent.TaskAgent, status string) []interface{} {
+	results := make([]interface{}, 0)
+	if agents == nil {
+		return results
+	}
+
+	for _, agent := range *agents {
+		if agent.Id == nil {
+			continue
+		}
+		agentStatus := ""
+		if agent.Status != nil {
+			agentStatus = string(*agent.Status)
+		}
+		if status != "" && status != agentStatus {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"id":                   *agent.Id,
+			agName:                 converter.ToString(agent.Name, ""),
+			agStatus:               agentStatus,
+			agVersion:              converter.ToString(agent.Version, ""),
+			agEnabled:              converter.ToBool(agent.Enabled, false),
+			agOsDescription:        converter.ToString(agent.OsDescription, ""),
+			agProvisioningState:    converter.ToString(agent.ProvisioningState, ""),
+			agSystemCapabilities:   map[string]string{},
+			agUserCapabilities:     map[string]string{},
+			agLastCompletedRequest: flattenAgentJobRequest(agent.LastCompletedRequest),
+		}
+		if agent.CreatedOn != nil {
+			result[agCreatedOn] = agent.CreatedOn.String()
+		}
+		if agent.StatusChangedOn != nil {
+			result[agStatusChangedOn] = agent.StatusChangedOn.String()
+		}
+		if agent.SystemCapabilities != nil {
+			result[agSystemCapabilities] = *agent.SystemCapabilities
+		}
+		if agent.UserCapabilities != nil {
+			result[agUserCapabilities] = *agent.UserCapabilities
+		}
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].(map[string]interface{})["id"].(int) < results[j].(map[string]interface{})["id"].(int)
+	})
+	return results
+}
+
+func flattenAgentJobRequest(request *taskagent.TaskAgentJobRequest) []interface{} {
+	if request == nil {
+		return []interface{}{}
+	}
+
+	result := map[string]interface{}{
+		"job_name": converter.ToString(request.JobName, ""),
+	}
+	if request.RequestId != nil {
+		result["request_id"] = int(*request.RequestId)
+	}
+	if request.Definition != nil {
+		result["definition_name"] = converter.ToString(request.Definition.Name, "")
+	}
+	if request.Result != nil {
+		result["result"] = string(*request.Result)
+	}
+	if request.QueueTime != nil {
+		result["queue_time"] = request.QueueTime.String()
+	}
+	if request.FinishTime != nil {
+		result["finish_time"] = request.FinishTime.String()
+	}
+	return []interface{}{result}
+}
@@ -0,0 +1,130 @@
+//go:build (all || data_sources || data_agents) && (!exclude_data_sources || !exclude_data_agents)
+// +build all data_sources data_agents
+// +build !exclude_data_sources !exclude_data_agents
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testAgentRequestID = uint64(42)
+
+var testAgents = []taskagent.TaskAgent{
+	{
+		Id:            converter.Int(2),
+		Name:          converter.String("agent-2"),
+		Status:        &taskagent.TaskAgentStatusValues.Offline,
+		Version:       converter.String("2.190.0"),
+		Enabled:       converter.Bool(false),
+		OsDescription: converter.String("Linux 5.4.0"),
+	},
+	{
+		Id:            converter.Int(1),
+		Name:          converter.String("agent-1"),
+		Status:        &taskagent.TaskAgentStatusValues.Online,
+		Version:       converter.String("2.210.1"),
+		Enabled:       converter.Bool(true),
+		OsDescription: converter.String("Linux 5.15.0"),
+		SystemCapabilities: &map[string]string{
+			"Agent.OS": "Linux",
+		},
+		UserCapabilities: &map[string]string{
+			"docker": "true",
+		},
+		LastCompletedRequest: &taskagent.TaskAgentJobRequest{
+			RequestId:  &testAgentRequestID,
+			JobName:    converter.String("Build"),
+			Result:     &taskagent.TaskResultValues.Succeeded,
+			Definition: &taskagent.TaskOrchestrationOwner{Name: converter.String("ci")},
+		},
+	},
+}
+
+// verifies that the agents are flattened including capabilities and the last completed request
+func TestDataSourceAgents_Flatten(t *testing.T) {
+	agents := flattenAgents(&testAgents, "")
+	require.Len(t, agents, 2)
+
+	first := agents[0].(map[string]interface{})
+	require.Equal(t, 1, first["id"])
+	require.Equal(t, "online", first[agStatus])
+	require.Equal(t, true, first[agEnabled])
+	require.Equal(t, map[string]string{"Agent.OS": "Linux"}, first[agSystemCapabilities])
+	require.Equal(t, map[string]string{"docker": "true"}, first[agUserCapabilities])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{
+			"request_id":      42,
+			"job_name":        "Build",
+			"definition_name": "ci",
+			"result":          "succeeded",
+		},
+	}, first[agLastCompletedRequest])
+
+	second := agents[1].(map[string]interface{})
+	require.Equal(t, "offline", second[agStatus])
+	require.Empty(t, second[agLastCompletedRequest])
+
+	offline := flattenAgents(&testAgents, "offline")
+	require.Len(t, offline, 1)
+	require.Equal(t, "agent-2", offline[0].(map[string]interface{})[agName])
+}
+
+// verifies that the demands are passed to the service
+func TestDataSourceAgents_Read_PassesDemands(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.Ctx, taskagent.GetAgentsArgs{
+			PoolId:                      converter.Int(1),
+			IncludeCapabilities:         converter.Bool(true),
+			IncludeLastCompletedRequest: converter.Bool(true),
+			Demands:                     &[]string{"docker", "Agent.OS -equals Linux"},
+		}).
+		Return(&testAgents, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgents().Schema, map[string]interface{}{
+		agPoolID:  1,
+		agDemands: []interface{}{"docker", "Agent.OS -equals Linux"},
+	})
+	err := dataSourceAgentsRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, resourceData.Get(agAgents).([]interface{}), 2)
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestDataSourceAgents_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetAgents() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgents().Schema, map[string]interface{}{
+		agPoolID: 1,
+	})
+	err := dataSourceAgentsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetAgents() Failed")
+}
@@ -0,0 +1,154 @@
+package taskagent
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	dgtDeploymentGroupID = "deployment_group_id"
+	dgtTags              = "tags"
+	dgtTargets           = "targets"
+)
+
+// DataDeploymentGroupTargets schema and implementation for the deployment targets of a deployment group
+func DataDeploymentGroupTargets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDeploymentGroupTargetsRead,
+		Schema: map[string]*schema.Schema{
+			dgProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			dgtDeploymentGroupID: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			dgtTags: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			dgtTargets: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentGroupTargetsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(dgProjectID).(string)
+	deploymentGroupID := d.Get(dgtDeploymentGroupID).(int)
+
+	args := taskagent.GetDeploymentTargetsArgs{
+		Project:           &projectID,
+		DeploymentGroupId: &deploymentGroupID,
+	}
+	if tags, ok := d.GetOk(dgtTags); ok {
+		tagList := tfhelper.ExpandStringSet(tags.(*schema.Set))
+		args.Tags = &tagList
+	}
+
+	targets, err := getDeploymentTargets(clients, args)
+	if err != nil {
+		return fmt.Errorf("Error finding the targets of deployment group %d in project %s. Error: %v", deploymentGroupID, projectID, err)
+	}
+
+	if err := d.Set(dgtTargets, flattenDeploymentTargets(targets)); err != nil {
+		return fmt.Errorf("Error setting targets field in state. Error: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectID, strconv.Itoa(deploymentGroupID)))
+	return nil
+}
+
+func getDeploymentTargets(clients *client.AggregatedClient, args taskagent.GetDeploymentTargetsArgs) ([]taskagent.DeploymentMachine, error) {
+	targets := []taskagent.DeploymentMachine{}
+	for {
+		response, err := clients.TaskAgentClient.GetDeploymentTargets(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, response.Value...)
+
+		if response.ContinuationToken == "" {
+			return targets, nil
+		}
+		args.ContinuationToken = converter.String(response.ContinuationToken)
+	}
+}
+
+func flattenDeploymentTargets(targets []taskagent.DeploymentMachine) []interface{} {
+	results := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		if target.Id == nil {
+			continue
+		}
+
+		result := map[string]interface{}{
+			"id":   *target.Id,
+			"tags": []string{},
+		}
+		if target.Tags != nil {
+			result["tags"] = *target.Tags
+		}
+		if target.Agent != nil {
+			result["name"] = converter.ToString(target.Agent.Name, "")
+			result["enabled"] = converter.ToBool(target.Agent.Enabled, false)
+			result["version"] = converter.ToString(target.Agent.Version, "")
+			if target.Agent.Status != nil {
+				result["status"] = string(*target.Agent.Status)
+			}
+		}
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].(map[string]interface{})["id"].(int) < results[j].(map[string]interface{})["id"].(int)
+	})
+	return results
+}
@@ -0,0 +1,109 @@
+//go:build (all || data_sources || data_deployment_group_targets) && (!exclude_data_sources || !exclude_data_deployment_group_targets)
+// +build all data_sources data_deployment_group_targets
+// +build !exclude_data_sources !exclude_data_deployment_group_targets
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeploymentTargetsProjectID = uuid.New().String()
+
+// verifies that all pages of deployment targets are read
+func TestDataSourceDeploymentGroupTargets_Read_Paging(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	firstArgs := taskagent.GetDeploymentTargetsArgs{
+		Project:           &testDeploymentTargetsProjectID,
+		DeploymentGroupId: converter.Int(3),
+	}
+	secondArgs := firstArgs
+	secondArgs.ContinuationToken = converter.String("next")
+
+	gomock.InOrder(
+		taskAgentClient.
+			EXPECT().
+			GetDeploymentTargets(clients.Ctx, firstArgs).
+			Return(&taskagent.GetDeploymentTargetsResponseValue{
+				Value: []taskagent.DeploymentMachine{
+					{
+						Id:   converter.Int(2),
+						Tags: &[]string{"web"},
+						Agent: &taskagent.TaskAgent{
+							Name:    converter.String("web-2"),
+							Status:  &taskagent.TaskAgentStatusValues.Offline,
+							Enabled: converter.Bool(true),
+							Version: converter.String("2.210.1"),
+						},
+					},
+				},
+				ContinuationToken: "next",
+			}, nil),
+		taskAgentClient.
+			EXPECT().
+			GetDeploymentTargets(clients.Ctx, secondArgs).
+			Return(&taskagent.GetDeploymentTargetsResponseValue{
+				Value: []taskagent.DeploymentMachine{
+					{
+						Id: converter.Int(1),
+						Agent: &taskagent.TaskAgent{
+							Name:   converter.String("web-1"),
+							Status: &taskagent.TaskAgentStatusValues.Online,
+						},
+					},
+				},
+			}, nil),
+	)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeploymentGroupTargets().Schema, map[string]interface{}{
+		dgProjectID:          testDeploymentTargetsProjectID,
+		dgtDeploymentGroupID: 3,
+	})
+	err := dataSourceDeploymentGroupTargetsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	targets := resourceData.Get(dgtTargets).([]interface{})
+	require.Len(t, targets, 2)
+	require.Equal(t, "web-1", targets[0].(map[string]interface{})["name"])
+	require.Equal(t, "online", targets[0].(map[string]interface{})["status"])
+	require.Equal(t, "offline", targets[1].(map[string]interface{})["status"])
+	require.Equal(t, 1, targets[1].(map[string]interface{})["tags"].(*schema.Set).Len())
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestDataSourceDeploymentGroupTargets_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentTargets(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetDeploymentTargets() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataDeploymentGroupTargets().Schema, map[string]interface{}{
+		dgProjectID:          testDeploymentTargetsProjectID,
+		dgtDeploymentGroupID: 3,
+	})
+	err := dataSourceDeploymentGroupTargetsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetDeploymentTargets() Failed")
+}
@@ -0,0 +1,141 @@
+package taskagent
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const envResVirtualMachines = "virtual_machines"
+
+// DataEnvironmentResourcesVirtualMachine schema and implementation for the virtual machine resources of an environment
+func DataEnvironmentResourcesVirtualMachine() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnvironmentResourcesVirtualMachineRead,
+		Schema: map[string]*schema.Schema{
+			envResProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			envResEnvironmentID: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			envResTags: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			envResVirtualMachines: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						envResName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						envResTags: {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentResourcesVirtualMachineRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(envResProjectID).(string)
+	environmentID := d.Get(envResEnvironmentID).(int)
+
+	environment, err := clients.TaskAgentClient.GetEnvironmentById(clients.Ctx, taskagent.GetEnvironmentByIdArgs{
+		Project:       &projectID,
+		EnvironmentId: &environmentID,
+		Expands:       &taskagent.EnvironmentExpandsValues.ResourceReferences,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading the resources of environment %d in project %s. Error: %v", environmentID, projectID, err)
+	}
+
+	var tags []string
+	if v, ok := d.GetOk(envResTags); ok {
+		tags = tfhelper.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if err := d.Set(envResVirtualMachines, flattenEnvironmentVirtualMachines(environment.Resources, tags)); err != nil {
+		return fmt.Errorf("Error setting virtual_machines field in state. Error: %v", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectID, strconv.Itoa(environmentID)))
+	return nil
+}
+
+// flattenEnvironmentVirtualMachines lists the virtual machine resources carrying all the given tags
+func flattenEnvironmentVirtualMachines(resources *[]taskagent.EnvironmentResourceReference, tags []string) []interface{} {
+	results := make([]interface{}, 0)
+	if resources == nil {
+		return results
+	}
+
+	for _, resource := range *resources {
+		if resource.Id == nil || resource.Type == nil || *resource.Type != taskagent.EnvironmentResourceTypeValues.VirtualMachine {
+			continue
+		}
+
+		resourceTags := []string{}
+		if resource.Tags != nil {
+			resourceTags = *resource.Tags
+		}
+		if !hasAllTags(resourceTags, tags) {
+			continue
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":       *resource.Id,
+			envResName: converter.ToString(resource.Name, ""),
+			envResTags: resourceTags,
+		})
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].(map[string]interface{})["id"].(int) < results[j].(map[string]interface{})["id"].(int)
+	})
+	return results
+}
+
+func hasAllTags(resourceTags []string, tags []string) bool {
+	for _, tag := range tags {
+		found := false
+		for _, resourceTag := range resourceTags {
+			if strings.EqualFold(tag, resourceTag) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
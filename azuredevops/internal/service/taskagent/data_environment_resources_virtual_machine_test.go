@@ -0,0 +1,104 @@
+//go:build (all || data_sources || data_environment_resources_virtual_machine) && (!exclude_data_sources || !exclude_data_environment_resources_virtual_machine)
+// +build all data_sources data_environment_resources_virtual_machine
+// +build !exclude_data_sources !exclude_data_environment_resources_virtual_machine
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testEnvironmentResourcesProjectID = uuid.New().String()
+
+var testEnvironmentResources = []taskagent.EnvironmentResourceReference{
+	{
+		Id:   converter.Int(3),
+		Name: converter.String("vm-web-2"),
+		Tags: &[]string{"web"},
+		Type: &taskagent.EnvironmentResourceTypeValues.VirtualMachine,
+	},
+	{
+		Id:   converter.Int(1),
+		Name: converter.String("vm-web-1"),
+		Tags: &[]string{"Web", "primary"},
+		Type: &taskagent.EnvironmentResourceTypeValues.VirtualMachine,
+	},
+	{
+		Id:   converter.Int(2),
+		Name: converter.String("app-namespace"),
+		Type: &taskagent.EnvironmentResourceTypeValues.Kubernetes,
+	},
+}
+
+// verifies that only virtual machine resources are listed, filtered by tags
+func TestDataSourceEnvironmentResourcesVirtualMachine_Flatten(t *testing.T) {
+	virtualMachines := flattenEnvironmentVirtualMachines(&testEnvironmentResources, nil)
+	require.Len(t, virtualMachines, 2)
+	require.Equal(t, 1, virtualMachines[0].(map[string]interface{})["id"])
+	require.Equal(t, 3, virtualMachines[1].(map[string]interface{})["id"])
+
+	virtualMachines = flattenEnvironmentVirtualMachines(&testEnvironmentResources, []string{"web", "primary"})
+	require.Len(t, virtualMachines, 1)
+	require.Equal(t, "vm-web-1", virtualMachines[0].(map[string]interface{})[envResName])
+}
+
+// verifies that the environment is read with its resource references
+func TestDataSourceEnvironmentResourcesVirtualMachine_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	projectID := testEnvironmentResourcesProjectID
+	taskAgentClient.
+		EXPECT().
+		GetEnvironmentById(clients.Ctx, taskagent.GetEnvironmentByIdArgs{
+			Project:       &projectID,
+			EnvironmentId: converter.Int(10),
+			Expands:       &taskagent.EnvironmentExpandsValues.ResourceReferences,
+		}).
+		Return(&taskagent.EnvironmentInstance{Resources: &testEnvironmentResources}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataEnvironmentResourcesVirtualMachine().Schema, map[string]interface{}{
+		envResProjectID:     projectID,
+		envResEnvironmentID: 10,
+	})
+	err := dataSourceEnvironmentResourcesVirtualMachineRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, resourceData.Get(envResVirtualMachines).([]interface{}), 2)
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestDataSourceEnvironmentResourcesVirtualMachine_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetEnvironmentById(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetEnvironmentById() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataEnvironmentResourcesVirtualMachine().Schema, map[string]interface{}{
+		envResProjectID:     testEnvironmentResourcesProjectID,
+		envResEnvironmentID: 10,
+	})
+	err := dataSourceEnvironmentResourcesVirtualMachineRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetEnvironmentById() Failed")
+}
@@ -0,0 +1,84 @@
+package taskagent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+)
+
+// DataSecureFile schema and implementation for secure file data source
+func DataSecureFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSecureFileRead,
+		Schema: map[string]*schema.Schema{
+			sfProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			sfName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			sfProperties: {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			sfAllowAccess: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSecureFileRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(sfProjectID).(string)
+	name := d.Get(sfName).(string)
+
+	secureFile, err := getSecureFileByName(clients, projectID, name)
+	if err != nil {
+		return fmt.Errorf("Error finding secure file with name %s. Error: %v", name, err)
+	}
+	if secureFile == nil {
+		return fmt.Errorf("Unable to find secure file with name: %s", name)
+	}
+
+	err = flattenSecureFile(d, secureFile, projectID)
+	if err != nil {
+		return fmt.Errorf(flatteningSecureFileErrorMessageFormat, err)
+	}
+
+	return readSecureFileAllowAccess(d, clients, projectID)
+}
+
+// getSecureFileByName looks up a secure file by its exact (case insensitive) name
+func getSecureFileByName(clients *client.AggregatedClient, projectID string, name string) (*taskagent.SecureFile, error) {
+	secureFiles, err := clients.TaskAgentClientExtras.GetSecureFiles(clients.Ctx, taskagentextras.GetSecureFilesArgs{
+		Project:     &projectID,
+		NamePattern: &name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if secureFiles == nil {
+		return nil, nil
+	}
+
+	for _, secureFile := range *secureFiles {
+		if secureFile.Name != nil && strings.EqualFold(*secureFile.Name, name) {
+			return &secureFile, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,94 @@
+package taskagent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataTaskGroup schema and implementation for task group data source
+func DataTaskGroup() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTaskGroupRead,
+		Schema: map[string]*schema.Schema{
+			tgProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			tgName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			tgDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tgCategory: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			tgRunsOn: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			tgPreview: {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			tgVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tgRevision: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTaskGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(tgProjectID).(string)
+	name := d.Get(tgName).(string)
+
+	taskGroups, err := clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+		Project: &projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading task groups of project %s: %+v", projectID, err)
+	}
+
+	// drafts share the name of the task group they belong to, so they are skipped
+	taskGroup := latestTaskGroupVersion(taskGroups, func(taskGroup *taskagent.TaskGroup) bool {
+		return taskGroup.ParentDefinitionId == nil && strings.EqualFold(converter.ToString(taskGroup.Name, ""), name)
+	})
+	if taskGroup == nil {
+		return fmt.Errorf("Could not find task group with name %s in project %s", name, projectID)
+	}
+
+	d.SetId(taskGroup.Id.String())
+	d.Set(tgName, converter.ToString(taskGroup.Name, ""))
+	d.Set(tgDescription, converter.ToString(taskGroup.Description, ""))
+	d.Set(tgCategory, converter.ToString(taskGroup.Category, ""))
+	d.Set(tgPreview, converter.ToBool(taskGroup.Preview, false))
+	d.Set(tgVersion, taskGroupMajorVersion(taskGroup))
+	d.Set(tgRevision, converter.ToInt(taskGroup.Revision, 0))
+
+	runsOn := []string{}
+	if taskGroup.RunsOn != nil {
+		runsOn = append(runsOn, *taskGroup.RunsOn...)
+	}
+	if err := d.Set(tgRunsOn, runsOn); err != nil {
+		return fmt.Errorf("Error setting runs_on of task group: %+v", err)
+	}
+	return nil
+}
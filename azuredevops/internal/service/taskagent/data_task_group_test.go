@@ -0,0 +1,79 @@
+//go:build (all || data_sources || data_task_group) && (!exclude_data_sources || !exclude_data_task_group)
+// +build all data_sources data_task_group
+// +build !exclude_data_sources !exclude_data_task_group
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDataTaskGroupProjectID = uuid.New().String()
+
+// verifies that the latest published version of a task group is found by its name and drafts are ignored
+func TestDataSourceTaskGroup_Read_FindsLatestVersionByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskGroupID := uuid.New()
+	otherTaskGroupID := uuid.New()
+	draftID := uuid.New()
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{Project: &testDataTaskGroupProjectID}).
+		Return(&[]taskagent.TaskGroup{
+			{Id: &taskGroupID, Name: converter.String("Deploy"), Version: &taskagent.TaskVersion{Major: converter.Int(1)}, Revision: converter.Int(3)},
+			{Id: &taskGroupID, Name: converter.String("Deploy"), Version: &taskagent.TaskVersion{Major: converter.Int(2)}, Revision: converter.Int(5), Preview: converter.Bool(true)},
+			{Id: &draftID, Name: converter.String("Deploy"), Version: &taskagent.TaskVersion{Major: converter.Int(3)}, ParentDefinitionId: &taskGroupID},
+			{Id: &otherTaskGroupID, Name: converter.String("Build"), Version: &taskagent.TaskVersion{Major: converter.Int(4)}},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTaskGroup().Schema, map[string]interface{}{
+		tgProjectID: testDataTaskGroupProjectID,
+		tgName:      "deploy",
+	})
+	err := dataSourceTaskGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, taskGroupID.String(), resourceData.Id())
+	require.Equal(t, "Deploy", resourceData.Get(tgName))
+	require.Equal(t, 2, resourceData.Get(tgVersion))
+	require.Equal(t, 5, resourceData.Get(tgRevision))
+	require.True(t, resourceData.Get(tgPreview).(bool))
+}
+
+// verifies that an error is returned if no task group has the name
+func TestDataSourceTaskGroup_Read_ReturnsErrorIfNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskGroup{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTaskGroup().Schema, map[string]interface{}{
+		tgProjectID: testDataTaskGroupProjectID,
+		tgName:      "missing",
+	})
+	err := dataSourceTaskGroupRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Could not find task group with name missing")
+}
@@ -84,6 +84,30 @@ func DataVariableGroup() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						vgLinkedSecrets: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									vgName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									vgContentType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									vgEnabled: {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									vgExpires: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -0,0 +1,224 @@
+package taskagent
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+const (
+	vgsNamePattern    = "name_pattern"
+	vgsKeyVaultLinked = "key_vault_linked"
+	vgsVariableGroups = "variable_groups"
+	vgsKeyVaultName   = "key_vault_name"
+)
+
+// DataVariableGroups schema and implementation for variable groups data source
+func DataVariableGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceVariableGroupsRead,
+		Schema: map[string]*schema.Schema{
+			vgProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			vgsNamePattern: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			vgsKeyVaultLinked: {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			vgsVariableGroups: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						vgName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						vgDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						vgAllowAccess: {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						vgsKeyVaultName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						vgServiceEndpointID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						vgVariable: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									vgName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									vgIsSecret: {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVariableGroupsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(vgProjectID).(string)
+
+	args := taskagent.GetVariableGroupsArgs{
+		Project: &projectID,
+	}
+	if namePattern, ok := d.GetOk(vgsNamePattern); ok {
+		args.GroupName = converter.String(namePattern.(string))
+	}
+
+	variableGroups, err := clients.TaskAgentClient.GetVariableGroups(clients.Ctx, args)
+	if err != nil {
+		return fmt.Errorf("Error finding variable groups in project %s. Error: %v", projectID, err)
+	}
+
+	resourceRefType := "variablegroup"
+	projectResources, err := clients.BuildClient.GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+		Project: &projectID,
+		Type:    &resourceRefType,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up the pipeline authorization of variable groups in project %s. Error: %v", projectID, err)
+	}
+
+	// a bool can't be left unset in the schema, so the raw configuration tells if the filter is used
+	var keyVaultLinked *bool
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		if filter := rawConfig.GetAttr(vgsKeyVaultLinked); !filter.IsNull() {
+			keyVaultLinked = converter.Bool(filter.True())
+		}
+	}
+
+	results, err := flattenVariableGroups(variableGroups, projectResources, keyVaultLinked)
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set(vgsVariableGroups, results); err != nil {
+		return fmt.Errorf("Error setting variable_groups field in state. Error: %v", err)
+	}
+
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+func flattenVariableGroups(variableGroups *[]taskagent.VariableGroup, projectResources *[]build.DefinitionResourceReference, keyVaultLinked *bool) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if variableGroups == nil {
+		return results, nil
+	}
+
+	authorized := map[string]bool{}
+	if projectResources != nil {
+		for _, projectResource := range *projectResources {
+			if projectResource.Id != nil {
+				authorized[*projectResource.Id] = converter.ToBool(projectResource.Authorized, false)
+			}
+		}
+	}
+
+	for _, variableGroup := range *variableGroups {
+		if variableGroup.Id == nil {
+			continue
+		}
+		isKeyVault := variableGroup.Type != nil && *variableGroup.Type == azureKeyVaultType
+		if keyVaultLinked != nil && *keyVaultLinked != isKeyVault {
+			continue
+		}
+
+		variables, err := flattenVariableGroupsVariables(variableGroup.Variables, isKeyVault)
+		if err != nil {
+			return nil, err
+		}
+
+		result := map[string]interface{}{
+			"id":          *variableGroup.Id,
+			vgName:        converter.ToString(variableGroup.Name, ""),
+			vgDescription: converter.ToString(variableGroup.Description, ""),
+			vgAllowAccess: authorized[strconv.Itoa(*variableGroup.Id)],
+			vgVariable:    variables,
+		}
+
+		if isKeyVault && variableGroup.ProviderData != nil {
+			providerDataAsJSON, err := json.Marshal(variableGroup.ProviderData)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to marshal provider data into JSON: %+v", err)
+			}
+			var providerData taskagent.AzureKeyVaultVariableGroupProviderData
+			if err := json.Unmarshal(providerDataAsJSON, &providerData); err != nil {
+				return nil, fmt.Errorf("Unable to unmarshal provider data (%+v): %+v", providerData, err)
+			}
+			result[vgsKeyVaultName] = converter.ToString(providerData.Vault, "")
+			if providerData.ServiceEndpointId != nil {
+				result[vgServiceEndpointID] = providerData.ServiceEndpointId.String()
+			}
+		}
+
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// flattenVariableGroupsVariables lists the variable names and secret flags, values are never exported
+func flattenVariableGroupsVariables(variables *map[string]interface{}, isKeyVault bool) ([]interface{}, error) {
+	results := make([]interface{}, 0)
+	if variables == nil {
+		return results, nil
+	}
+
+	names := make([]string, 0, len(*variables))
+	for name := range *variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		variable, err := toVariableValue((*variables)[name])
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, map[string]interface{}{
+			vgName: name,
+			// secrets linked from an Azure Key Vault are always secret
+			vgIsSecret: isKeyVault || converter.ToBool(variable.IsSecret, false),
+		})
+	}
+	return results, nil
+}
@@ -0,0 +1,149 @@
+//go:build (all || data_sources || data_variable_groups) && (!exclude_data_sources || !exclude_data_variable_groups)
+// +build all data_sources data_variable_groups
+// +build !exclude_data_sources !exclude_data_variable_groups
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var dataTestVariableGroupsProjectID = uuid.New().String()
+var dataTestVariableGroupsServiceEndpointID = uuid.New()
+
+var dataTestVariableGroups = []taskagent.VariableGroup{
+	{
+		Id:          converter.Int(1),
+		Name:        converter.String("app-settings"),
+		Description: converter.String("Application settings"),
+		Type:        converter.String("Vsts"),
+		Variables: &map[string]interface{}{
+			"password": map[string]interface{}{"value": nil, "isSecret": true},
+			"endpoint": map[string]interface{}{"value": "https://example.com"},
+		},
+	},
+	{
+		Id:   converter.Int(2),
+		Name: converter.String("app-secrets"),
+		Type: converter.String(azureKeyVaultType),
+		ProviderData: map[string]interface{}{
+			"serviceEndpointId": dataTestVariableGroupsServiceEndpointID.String(),
+			"vault":             "example-kv",
+		},
+		Variables: &map[string]interface{}{
+			"certificate": map[string]interface{}{"enabled": true},
+		},
+	},
+}
+
+func setupVariableGroupsMocks(ctrl *gomock.Controller, groupName *string) *client.AggregatedClient {
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		BuildClient:     buildClient,
+		Ctx:             context.Background(),
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetVariableGroups(clients.Ctx, taskagent.GetVariableGroupsArgs{
+			Project:   &dataTestVariableGroupsProjectID,
+			GroupName: groupName,
+		}).
+		Return(&dataTestVariableGroups, nil).
+		Times(1)
+
+	resourceType := "variablegroup"
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+			Project: &dataTestVariableGroupsProjectID,
+			Type:    &resourceType,
+		}).
+		Return(&[]build.DefinitionResourceReference{
+			{Id: converter.String("2"), Authorized: converter.Bool(true)},
+		}, nil).
+		Times(1)
+
+	return clients
+}
+
+func TestDataSourceVariableGroups_Read_AllGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	namePattern := "app-*"
+	clients := setupVariableGroupsMocks(ctrl, &namePattern)
+
+	resourceData := schema.TestResourceDataRaw(t, DataVariableGroups().Schema, map[string]interface{}{
+		vgProjectID:    dataTestVariableGroupsProjectID,
+		vgsNamePattern: namePattern,
+	})
+	err := dataSourceVariableGroupsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	variableGroups := resourceData.Get(vgsVariableGroups).([]interface{})
+	require.Len(t, variableGroups, 2)
+
+	first := variableGroups[0].(map[string]interface{})
+	require.Equal(t, 1, first["id"])
+	require.Equal(t, "Application settings", first[vgDescription])
+	require.Equal(t, false, first[vgAllowAccess])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{vgName: "endpoint", vgIsSecret: false},
+		map[string]interface{}{vgName: "password", vgIsSecret: true},
+	}, first[vgVariable])
+
+	second := variableGroups[1].(map[string]interface{})
+	require.Equal(t, true, second[vgAllowAccess])
+	require.Equal(t, "example-kv", second[vgsKeyVaultName])
+	require.Equal(t, dataTestVariableGroupsServiceEndpointID.String(), second[vgServiceEndpointID])
+}
+
+func TestDataSourceVariableGroups_FlattenFilterKeyVaultLinked(t *testing.T) {
+	variableGroups, err := flattenVariableGroups(&dataTestVariableGroups, nil, converter.Bool(false))
+	require.Nil(t, err)
+	require.Len(t, variableGroups, 1)
+	require.Equal(t, "app-settings", variableGroups[0].(map[string]interface{})[vgName])
+
+	variableGroups, err = flattenVariableGroups(&dataTestVariableGroups, nil, converter.Bool(true))
+	require.Nil(t, err)
+	require.Len(t, variableGroups, 1)
+	require.Equal(t, "app-secrets", variableGroups[0].(map[string]interface{})[vgName])
+}
+
+func TestDataSourceVariableGroups_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetVariableGroups(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetVariableGroups() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataVariableGroups().Schema, map[string]interface{}{
+		vgProjectID: dataTestVariableGroupsProjectID,
+	})
+	err := dataSourceVariableGroupsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetVariableGroups() Failed")
+}
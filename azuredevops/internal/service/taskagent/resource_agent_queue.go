@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
@@ -17,15 +18,19 @@ import (
 const (
 	agentPoolID                      = "agent_pool_id"
 	projectID                        = "project_id"
+	authorizeAllPipelines            = "authorize_all_pipelines"
+	queueResourceType                = "queue"
 	invalidQueueIDErrorMessageFormat = "Queue ID was unexpectedly not a valid integer: %+v"
 )
 
 // ResourceAgentQueue schema and implementation for agent queue resource
 func ResourceAgentQueue() *schema.Resource {
-	// Note: there is no update API, so all fields will require a new resource
+	// Note: there is no update API for the queue itself, so all fields except the
+	// pipeline authorization will require a new resource
 	return &schema.Resource{
 		Create:   resourceAgentQueueCreate,
 		Read:     resourceAgentQueueRead,
+		Update:   resourceAgentQueueUpdate,
 		Delete:   resourceAgentQueueDelete,
 		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
 		Schema: map[string]*schema.Schema{
@@ -41,6 +46,11 @@ func ResourceAgentQueue() *schema.Resource {
 				ValidateFunc:     validation.NoZeroValues,
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			authorizeAllPipelines: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -63,7 +73,7 @@ func resourceAgentQueueCreate(d *schema.ResourceData, m interface{}) error {
 	createdQueue, err := clients.TaskAgentClient.AddAgentQueue(clients.Ctx, taskagent.AddAgentQueueArgs{
 		Queue:              queue,
 		Project:            &projectID,
-		AuthorizePipelines: converter.Bool(false),
+		AuthorizePipelines: expandAgentQueueAuthorizePipelines(d),
 	})
 
 	if err != nil {
@@ -92,6 +102,19 @@ func expandAgentQueue(d *schema.ResourceData) (*taskagent.TaskAgentQueue, string
 	return queue, d.Get(projectID).(string), nil
 }
 
+// expandAgentQueueAuthorizePipelines returns the configured pipeline authorization. The authorization is left
+// unchanged if it isn't configured, as a bool can't be left unset in the schema the raw configuration is used.
+func expandAgentQueueAuthorizePipelines(d *schema.ResourceData) *bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+	if authorize := rawConfig.GetAttr(authorizeAllPipelines); !authorize.IsNull() {
+		return converter.Bool(authorize.True())
+	}
+	return nil
+}
+
 func resourceAgentQueueRead(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	queueID, err := converter.ASCIIToIntPtr(d.Id())
@@ -117,9 +140,42 @@ func resourceAgentQueueRead(d *schema.ResourceData, m interface{}) error {
 		d.Set(agentPoolID, *queue.Pool.Id)
 	}
 
+	projectResources, err := clients.BuildClient.GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+		Project: converter.String(d.Get(projectID).(string)),
+		Type:    converter.String(queueResourceType),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading the pipeline authorization of the agent queue: %+v", err)
+	}
+	d.Set(authorizeAllPipelines, isDefinitionResourceAuthorized(d.Id(), projectResources))
+
 	return nil
 }
 
+func resourceAgentQueueUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	if _, err := converter.ASCIIToIntPtr(d.Id()); err != nil {
+		return fmt.Errorf(invalidQueueIDErrorMessageFormat, err)
+	}
+
+	if authorize := expandAgentQueueAuthorizePipelines(d); authorize != nil && d.HasChange(authorizeAllPipelines) {
+		_, err := clients.BuildClient.AuthorizeProjectResources(clients.Ctx, build.AuthorizeProjectResourcesArgs{
+			Project: converter.String(d.Get(projectID).(string)),
+			Resources: &[]build.DefinitionResourceReference{{
+				Type:       converter.String(queueResourceType),
+				Id:         converter.String(d.Id()),
+				Authorized: authorize,
+			}},
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating the pipeline authorization of the agent queue: %+v", err)
+		}
+	}
+
+	return resourceAgentQueueRead(d, m)
+}
+
 func resourceAgentQueueDelete(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	queueID, err := converter.ASCIIToIntPtr(d.Id())
@@ -11,6 +11,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -68,8 +69,7 @@ func TestAgentQueue_DoesNotSwallowQueueCreateErrors(t *testing.T) {
 					Id: &agentQueuePoolID,
 				},
 			},
-			Project:            &agentQueueProject,
-			AuthorizePipelines: converter.Bool(false),
+			Project: &agentQueueProject,
 		}).
 		Return(nil, errors.New("AddAgentQueue() Failed"))
 
@@ -99,6 +99,67 @@ func TestAgentQueue_DoesNotSwallowReadErrors(t *testing.T) {
 	require.Contains(t, err.Error(), "GetAgentQueue() Failed")
 }
 
+// A read should report whether the queue is authorized for all pipelines
+func TestAgentQueue_ReadsPipelineAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := generateResourceData(t, &agentQueueProject, &agentQueuePoolID, &agentQueueID)
+	agentClient, clients := generateMocks(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients.BuildClient = buildClient
+
+	agentClient.
+		EXPECT().
+		GetAgentQueue(clients.Ctx, gomock.Any()).
+		Return(&taskagent.TaskAgentQueue{
+			Id:   &agentQueueID,
+			Pool: &taskagent.TaskAgentPoolReference{Id: &agentQueuePoolID},
+		}, nil)
+
+	queueID := strconv.Itoa(agentQueueID)
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+			Project: &agentQueueProject,
+			Type:    converter.String("queue"),
+			Id:      &queueID,
+		}).
+		Return(&[]build.DefinitionResourceReference{{
+			Id:         &queueID,
+			Authorized: converter.Bool(true),
+		}}, nil)
+
+	err := resourceAgentQueueRead(resourceData, clients)
+	require.Nil(t, err)
+	require.True(t, resourceData.Get(authorizeAllPipelines).(bool))
+}
+
+// If reading the pipeline authorization fails, an error should be reported
+func TestAgentQueue_DoesNotSwallowPipelineAuthorizationReadErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := generateResourceData(t, &agentQueueProject, &agentQueuePoolID, &agentQueueID)
+	agentClient, clients := generateMocks(ctrl)
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients.BuildClient = buildClient
+
+	agentClient.
+		EXPECT().
+		GetAgentQueue(clients.Ctx, gomock.Any()).
+		Return(&taskagent.TaskAgentQueue{Id: &agentQueueID}, nil)
+
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetProjectResources() Failed"))
+
+	err := resourceAgentQueueRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetProjectResources() Failed")
+}
+
 func TestAgentQueue_DoesNotSwallowDeleteErrors(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
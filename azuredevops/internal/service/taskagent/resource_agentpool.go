@@ -3,6 +3,7 @@ package taskagent
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -10,11 +11,25 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
 )
 
+var maintenanceDays = map[string]taskagent.TaskAgentPoolMaintenanceScheduleDays{
+	"Mon": taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.Monday,
+	"Tue": taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.Tuesday,
+	"Wed": taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.Wednesday,
+	"Thu": taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.Thursday,
+	"Fri": taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.Friday,
+	"Sat": taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.Saturday,
+	"Sun": taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.Sunday,
+}
+
+var maintenanceDaysOrder = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
 // ResourceAgentPool schema and implementation for agent pool resource
 func ResourceAgentPool() *schema.Resource {
 	return &schema.Resource{
@@ -59,6 +74,81 @@ func ResourceAgentPool() *schema.Resource {
 				Optional: true,
 				Default:  true,
 			},
+			"maintenance": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"job_timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_concurrent_agents_percentage": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      25,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"working_directory_expiration_in_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"records_to_keep": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"schedule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice(maintenanceDaysOrder, false),
+										},
+									},
+									"start_hours": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntBetween(0, 23),
+									},
+									"start_minutes": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Default:      0,
+										ValidateFunc: validation.IntBetween(0, 59),
+									},
+									"time_zone": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "(UTC) Coordinated Universal Time",
+										ValidateFunc: validation.StringInSlice(build.TimeZones, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -102,6 +192,10 @@ func resourceAzureAgentPoolCreate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 	d.SetId(strconv.Itoa(*agentPool.Id))
+
+	if err := updateAgentPoolMaintenance(d, clients, *agentPool.Id); err != nil {
+		return err
+	}
 	return resourceAzureAgentPoolRead(d, m)
 }
 
@@ -129,6 +223,14 @@ func resourceAzureAgentPoolRead(d *schema.ResourceData, m interface{}) error {
 	if agentPool.AutoUpdate != nil {
 		d.Set("auto_update", agentPool.AutoUpdate)
 	}
+
+	definition, err := getAgentPoolMaintenanceDefinition(clients, poolID)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("maintenance", flattenAgentPoolMaintenance(definition)); err != nil {
+		return fmt.Errorf(" setting maintenance of Agent Pool with ID %d. Error: %v", poolID, err)
+	}
 	return nil
 }
 
@@ -158,6 +260,12 @@ func resourceAzureAgentPoolUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	if d.HasChange("maintenance") {
+		if err := updateAgentPoolMaintenance(d, clients, poolID); err != nil {
+			return err
+		}
+	}
+
 	return resourceAzureAgentPoolRead(d, m)
 }
 
@@ -229,3 +337,164 @@ func syncStatus(params taskagent.UpdateAgentPoolArgs, client *client.AggregatedC
 	}
 	return nil
 }
+
+// updateAgentPoolMaintenance creates, updates or removes the maintenance definition of the agent pool
+func updateAgentPoolMaintenance(d *schema.ResourceData, clients *client.AggregatedClient, poolID int) error {
+	existing, err := getAgentPoolMaintenanceDefinition(clients, poolID)
+	if err != nil {
+		return err
+	}
+
+	maintenance := d.Get("maintenance").([]interface{})
+	if len(maintenance) == 0 || maintenance[0] == nil {
+		if existing == nil {
+			return nil
+		}
+		err := clients.TaskAgentClientExtras.DeleteAgentPoolMaintenanceDefinition(clients.Ctx, taskagentextras.DeleteAgentPoolMaintenanceDefinitionArgs{
+			PoolId:       &poolID,
+			DefinitionId: existing.Id,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf(" deleting maintenance definition of Agent Pool with ID %d. Error: %v", poolID, err)
+		}
+		return nil
+	}
+
+	definition := expandAgentPoolMaintenance(maintenance[0].(map[string]interface{}), poolID)
+	if existing == nil {
+		_, err = clients.TaskAgentClientExtras.CreateAgentPoolMaintenanceDefinition(clients.Ctx, taskagentextras.CreateAgentPoolMaintenanceDefinitionArgs{
+			PoolId:     &poolID,
+			Definition: definition,
+		})
+		if err != nil {
+			return fmt.Errorf(" creating maintenance definition of Agent Pool with ID %d. Error: %v", poolID, err)
+		}
+		return nil
+	}
+
+	definition.Id = existing.Id
+	if existing.ScheduleSetting != nil {
+		definition.ScheduleSetting.ScheduleJobId = existing.ScheduleSetting.ScheduleJobId
+	}
+	_, err = clients.TaskAgentClientExtras.UpdateAgentPoolMaintenanceDefinition(clients.Ctx, taskagentextras.UpdateAgentPoolMaintenanceDefinitionArgs{
+		PoolId:       &poolID,
+		DefinitionId: existing.Id,
+		Definition:   definition,
+	})
+	if err != nil {
+		return fmt.Errorf(" updating maintenance definition of Agent Pool with ID %d. Error: %v", poolID, err)
+	}
+	return nil
+}
+
+// getAgentPoolMaintenanceDefinition returns the maintenance definition of the agent pool, an agent pool has at most one
+func getAgentPoolMaintenanceDefinition(clients *client.AggregatedClient, poolID int) (*taskagent.TaskAgentPoolMaintenanceDefinition, error) {
+	definitions, err := clients.TaskAgentClientExtras.GetAgentPoolMaintenanceDefinitions(clients.Ctx, taskagentextras.GetAgentPoolMaintenanceDefinitionsArgs{
+		PoolId: &poolID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf(" looking up maintenance definitions of Agent Pool with ID %d. Error: %v", poolID, err)
+	}
+	if definitions == nil || len(*definitions) == 0 {
+		return nil, nil
+	}
+	return &(*definitions)[0], nil
+}
+
+func expandAgentPoolMaintenance(maintenance map[string]interface{}, poolID int) *taskagent.TaskAgentPoolMaintenanceDefinition {
+	definition := &taskagent.TaskAgentPoolMaintenanceDefinition{
+		Enabled:                       converter.Bool(maintenance["enabled"].(bool)),
+		JobTimeoutInMinutes:           converter.Int(maintenance["job_timeout_in_minutes"].(int)),
+		MaxConcurrentAgentsPercentage: converter.Int(maintenance["max_concurrent_agents_percentage"].(int)),
+		Options: &taskagent.TaskAgentPoolMaintenanceOptions{
+			WorkingDirectoryExpirationInDays: converter.Int(maintenance["working_directory_expiration_in_days"].(int)),
+		},
+		Pool: &taskagent.TaskAgentPoolReference{
+			Id: &poolID,
+		},
+		RetentionPolicy: &taskagent.TaskAgentPoolMaintenanceRetentionPolicy{
+			NumberOfHistoryRecordsToKeep: converter.Int(maintenance["records_to_keep"].(int)),
+		},
+		ScheduleSetting: &taskagent.TaskAgentPoolMaintenanceSchedule{
+			DaysToBuild: &taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.None,
+		},
+	}
+
+	if schedules := maintenance["schedule"].([]interface{}); len(schedules) > 0 && schedules[0] != nil {
+		schedule := schedules[0].(map[string]interface{})
+		definition.ScheduleSetting = &taskagent.TaskAgentPoolMaintenanceSchedule{
+			DaysToBuild:  expandMaintenanceDays(schedule["days"].(*schema.Set)),
+			StartHours:   converter.Int(schedule["start_hours"].(int)),
+			StartMinutes: converter.Int(schedule["start_minutes"].(int)),
+			TimeZoneId:   converter.String(build.TimeZoneToID[schedule["time_zone"].(string)]),
+		}
+	}
+	return definition
+}
+
+func flattenAgentPoolMaintenance(definition *taskagent.TaskAgentPoolMaintenanceDefinition) []interface{} {
+	if definition == nil {
+		return []interface{}{}
+	}
+
+	maintenance := map[string]interface{}{
+		"enabled":                          converter.ToBool(definition.Enabled, false),
+		"job_timeout_in_minutes":           converter.ToInt(definition.JobTimeoutInMinutes, 0),
+		"max_concurrent_agents_percentage": converter.ToInt(definition.MaxConcurrentAgentsPercentage, 0),
+	}
+	if definition.Options != nil {
+		maintenance["working_directory_expiration_in_days"] = converter.ToInt(definition.Options.WorkingDirectoryExpirationInDays, 0)
+	}
+	if definition.RetentionPolicy != nil {
+		maintenance["records_to_keep"] = converter.ToInt(definition.RetentionPolicy.NumberOfHistoryRecordsToKeep, 0)
+	}
+	if definition.ScheduleSetting != nil {
+		schedule := map[string]interface{}{
+			"days":          flattenMaintenanceDays(definition.ScheduleSetting.DaysToBuild),
+			"start_hours":   converter.ToInt(definition.ScheduleSetting.StartHours, 0),
+			"start_minutes": converter.ToInt(definition.ScheduleSetting.StartMinutes, 0),
+		}
+		if definition.ScheduleSetting.TimeZoneId != nil {
+			schedule["time_zone"] = build.IDToTimeZones[*definition.ScheduleSetting.TimeZoneId]
+		}
+		maintenance["schedule"] = []interface{}{schedule}
+	}
+	return []interface{}{maintenance}
+}
+
+// expandMaintenanceDays converts [Tue, Mon] into the flags "monday, tuesday"
+func expandMaintenanceDays(days *schema.Set) *taskagent.TaskAgentPoolMaintenanceScheduleDays {
+	if days == nil || days.Len() == 0 {
+		return &taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.None
+	}
+
+	names := make([]string, 0, days.Len())
+	for _, day := range maintenanceDaysOrder {
+		if days.Contains(day) {
+			names = append(names, string(maintenanceDays[day]))
+		}
+	}
+	value := taskagent.TaskAgentPoolMaintenanceScheduleDays(strings.Join(names, ", "))
+	return &value
+}
+
+// flattenMaintenanceDays converts the flags "monday, tuesday" or "all" into [Mon, Tue]
+func flattenMaintenanceDays(days *taskagent.TaskAgentPoolMaintenanceScheduleDays) []interface{} {
+	results := make([]interface{}, 0)
+	if days == nil {
+		return results
+	}
+
+	flags := map[string]bool{}
+	for _, flag := range strings.Split(string(*days), ",") {
+		flags[strings.ToLower(strings.TrimSpace(flag))] = true
+	}
+
+	all := flags[string(taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.All)]
+	for _, day := range maintenanceDaysOrder {
+		if all || flags[string(maintenanceDays[day])] {
+			results = append(results, day)
+		}
+	}
+	return results
+}
@@ -0,0 +1,133 @@
+//go:build (all || resource_agentpool) && !exclude_resource_agentpool
+// +build all resource_agentpool
+// +build !exclude_resource_agentpool
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testMaintenanceDays = taskagent.TaskAgentPoolMaintenanceScheduleDays("monday, saturday")
+
+var testMaintenanceDefinition = taskagent.TaskAgentPoolMaintenanceDefinition{
+	Enabled:                       converter.Bool(true),
+	JobTimeoutInMinutes:           converter.Int(120),
+	MaxConcurrentAgentsPercentage: converter.Int(50),
+	Options: &taskagent.TaskAgentPoolMaintenanceOptions{
+		WorkingDirectoryExpirationInDays: converter.Int(7),
+	},
+	Pool: &taskagent.TaskAgentPoolReference{
+		Id: converter.Int(1),
+	},
+	RetentionPolicy: &taskagent.TaskAgentPoolMaintenanceRetentionPolicy{
+		NumberOfHistoryRecordsToKeep: converter.Int(5),
+	},
+	ScheduleSetting: &taskagent.TaskAgentPoolMaintenanceSchedule{
+		DaysToBuild:  &testMaintenanceDays,
+		StartHours:   converter.Int(3),
+		StartMinutes: converter.Int(30),
+		TimeZoneId:   converter.String("W. Europe Standard Time"),
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same maintenance definition
+func TestAgentPool_Maintenance_ExpandFlatten_Roundtrip(t *testing.T) {
+	maintenance := flattenAgentPoolMaintenance(&testMaintenanceDefinition)
+	require.Len(t, maintenance, 1)
+
+	schedule := maintenance[0].(map[string]interface{})["schedule"].([]interface{})[0].(map[string]interface{})
+	require.Equal(t, []interface{}{"Mon", "Sat"}, schedule["days"])
+	require.Equal(t, "(UTC+01:00) Amsterdam, Berlin, Bern, Rome, Stockholm, Vienna", schedule["time_zone"])
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentPool().Schema, nil)
+	require.Nil(t, resourceData.Set("maintenance", maintenance))
+	definition := expandAgentPoolMaintenance(resourceData.Get("maintenance").([]interface{})[0].(map[string]interface{}), 1)
+	require.Equal(t, testMaintenanceDefinition, *definition)
+}
+
+// verifies that the days are converted into flags in the order of the week, regardless of the configured order
+func TestAgentPool_Maintenance_ExpandDaysInWeekOrder(t *testing.T) {
+	days := schema.NewSet(schema.HashString, []interface{}{"Sat", "Mon", "Wed"})
+	require.Equal(t, taskagent.TaskAgentPoolMaintenanceScheduleDays("monday, wednesday, saturday"), *expandMaintenanceDays(days))
+	require.Equal(t, taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.None, *expandMaintenanceDays(nil))
+}
+
+// verifies that all days are listed if the maintenance runs every day
+func TestAgentPool_Maintenance_FlattenAllDays(t *testing.T) {
+	require.Equal(t,
+		[]interface{}{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
+		flattenMaintenanceDays(&taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.All))
+	require.Empty(t, flattenMaintenanceDays(&taskagent.TaskAgentPoolMaintenanceScheduleDaysValues.None))
+}
+
+// verifies that the existing maintenance definition is updated instead of adding another one
+func TestAgentPool_Maintenance_UpdatesExistingDefinition(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentPool().Schema, nil)
+	resourceData.Set("maintenance", flattenAgentPoolMaintenance(&testMaintenanceDefinition))
+
+	poolID := 1
+	existing := testMaintenanceDefinition
+	existing.Id = converter.Int(7)
+	taskAgentClientExtras.
+		EXPECT().
+		GetAgentPoolMaintenanceDefinitions(clients.Ctx, taskagentextras.GetAgentPoolMaintenanceDefinitionsArgs{PoolId: &poolID}).
+		Return(&[]taskagent.TaskAgentPoolMaintenanceDefinition{existing}, nil).
+		Times(1)
+
+	taskAgentClientExtras.
+		EXPECT().
+		UpdateAgentPoolMaintenanceDefinition(clients.Ctx, taskagentextras.UpdateAgentPoolMaintenanceDefinitionArgs{
+			PoolId:       &poolID,
+			DefinitionId: existing.Id,
+			Definition:   &existing,
+		}).
+		Return(&existing, nil).
+		Times(1)
+
+	err := updateAgentPoolMaintenance(resourceData, clients, poolID)
+	require.Nil(t, err)
+}
+
+// verifies that if an error is produced while creating the maintenance definition, it is not swallowed
+func TestAgentPool_Maintenance_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentPool().Schema, nil)
+	resourceData.Set("maintenance", flattenAgentPoolMaintenance(&testMaintenanceDefinition))
+
+	taskAgentClientExtras.
+		EXPECT().
+		GetAgentPoolMaintenanceDefinitions(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskAgentPoolMaintenanceDefinition{}, nil).
+		Times(1)
+
+	taskAgentClientExtras.
+		EXPECT().
+		CreateAgentPoolMaintenanceDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreateAgentPoolMaintenanceDefinition() Failed")).
+		Times(1)
+
+	err := updateAgentPoolMaintenance(resourceData, clients, 1)
+	require.Contains(t, err.Error(), "CreateAgentPoolMaintenanceDefinition() Failed")
+}
@@ -0,0 +1,182 @@
+package taskagent
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	dgProjectID    = "project_id"
+	dgName         = "name"
+	dgDescription  = "description"
+	dgPoolID       = "pool_id"
+	dgTargetTags   = "target_tags"
+	dgMachineCount = "machine_count"
+)
+
+// ResourceDeploymentGroup schema and implementation for deployment group resource
+func ResourceDeploymentGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceDeploymentGroupCreate,
+		Read:     resourceDeploymentGroupRead,
+		Update:   resourceDeploymentGroupUpdate,
+		Delete:   resourceDeploymentGroupDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			dgProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			dgName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			dgDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			dgPoolID: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			dgTargetTags: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			dgMachineCount: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentGroupCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(dgProjectID).(string)
+
+	createParameter := &taskagent.DeploymentGroupCreateParameter{
+		Name:        converter.String(d.Get(dgName).(string)),
+		Description: converter.String(d.Get(dgDescription).(string)),
+	}
+	// without a pool, Azure DevOps creates a deployment pool for the deployment group
+	if poolID, ok := d.GetOk(dgPoolID); ok {
+		createParameter.PoolId = converter.Int(poolID.(int))
+	}
+
+	deploymentGroup, err := clients.TaskAgentClient.AddDeploymentGroup(clients.Ctx, taskagent.AddDeploymentGroupArgs{
+		Project:         &projectID,
+		DeploymentGroup: createParameter,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating deployment group in Azure DevOps: %+v", err)
+	}
+
+	d.SetId(strconv.Itoa(*deploymentGroup.Id))
+	return resourceDeploymentGroupRead(d, m)
+}
+
+func resourceDeploymentGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID, deploymentGroupID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return fmt.Errorf("Error parsing the deployment group ID: %+v", err)
+	}
+
+	deploymentGroup, err := clients.TaskAgentClient.GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+		Project:           &projectID,
+		DeploymentGroupId: &deploymentGroupID,
+		Expand:            &taskagent.DeploymentGroupExpandsValues.Tags,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading the deployment group resource: %+v", err)
+	}
+	if deploymentGroup == nil || deploymentGroup.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	flattenDeploymentGroup(d, deploymentGroup)
+	return nil
+}
+
+func resourceDeploymentGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID, deploymentGroupID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return fmt.Errorf("Error parsing the deployment group ID: %+v", err)
+	}
+
+	_, err = clients.TaskAgentClient.UpdateDeploymentGroup(clients.Ctx, taskagent.UpdateDeploymentGroupArgs{
+		Project:           &projectID,
+		DeploymentGroupId: &deploymentGroupID,
+		DeploymentGroup: &taskagent.DeploymentGroupUpdateParameter{
+			Name:        converter.String(d.Get(dgName).(string)),
+			Description: converter.String(d.Get(dgDescription).(string)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating deployment group in Azure DevOps: %+v", err)
+	}
+
+	return resourceDeploymentGroupRead(d, m)
+}
+
+func resourceDeploymentGroupDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID, deploymentGroupID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return fmt.Errorf("Error parsing the deployment group ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteDeploymentGroup(clients.Ctx, taskagent.DeleteDeploymentGroupArgs{
+		Project:           &projectID,
+		DeploymentGroupId: &deploymentGroupID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf("Error deleting deployment group: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenDeploymentGroup(d *schema.ResourceData, deploymentGroup *taskagent.DeploymentGroup) {
+	d.Set(dgName, converter.ToString(deploymentGroup.Name, ""))
+	d.Set(dgDescription, converter.ToString(deploymentGroup.Description, ""))
+	d.Set(dgMachineCount, converter.ToInt(deploymentGroup.MachineCount, 0))
+	if deploymentGroup.Project != nil && deploymentGroup.Project.Id != nil {
+		d.Set(dgProjectID, deploymentGroup.Project.Id.String())
+	}
+	if deploymentGroup.Pool != nil && deploymentGroup.Pool.Id != nil {
+		d.Set(dgPoolID, *deploymentGroup.Pool.Id)
+	}
+
+	tags := []string{}
+	if deploymentGroup.MachineTags != nil {
+		tags = append(tags, *deploymentGroup.MachineTags...)
+	}
+	sort.Strings(tags)
+	d.Set(dgTargetTags, tags)
+}
@@ -0,0 +1,100 @@
+//go:build (all || resource_deployment_group) && !exclude_resource_deployment_group
+// +build all resource_deployment_group
+// +build !exclude_resource_deployment_group
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeploymentGroupProjectID = uuid.New()
+
+var testDeploymentGroup = taskagent.DeploymentGroup{
+	Id:           converter.Int(3),
+	Name:         converter.String("web-servers"),
+	Description:  converter.String("IIS servers"),
+	Pool:         &taskagent.TaskAgentPoolReference{Id: converter.Int(12)},
+	Project:      &taskagent.ProjectReference{Id: &testDeploymentGroupProjectID},
+	MachineCount: converter.Int(2),
+	MachineTags:  &[]string{"web", "east"},
+}
+
+// verifies that the deployment group is flattened into the state
+func TestDeploymentGroup_Flatten(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	flattenDeploymentGroup(resourceData, &testDeploymentGroup)
+
+	require.Equal(t, "web-servers", resourceData.Get(dgName))
+	require.Equal(t, "IIS servers", resourceData.Get(dgDescription))
+	require.Equal(t, 12, resourceData.Get(dgPoolID))
+	require.Equal(t, 2, resourceData.Get(dgMachineCount))
+	require.Equal(t, testDeploymentGroupProjectID.String(), resourceData.Get(dgProjectID))
+	require.Equal(t, []interface{}{"east", "web"}, resourceData.Get(dgTargetTags))
+}
+
+// verifies that the deployment pool is passed on create
+func TestDeploymentGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceDeploymentGroup()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set(dgProjectID, testDeploymentGroupProjectID.String())
+	resourceData.Set(dgName, "web-servers")
+	resourceData.Set(dgPoolID, 12)
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	projectID := testDeploymentGroupProjectID.String()
+	taskAgentClient.
+		EXPECT().
+		AddDeploymentGroup(clients.Ctx, taskagent.AddDeploymentGroupArgs{
+			Project: &projectID,
+			DeploymentGroup: &taskagent.DeploymentGroupCreateParameter{
+				Name:        converter.String("web-servers"),
+				Description: converter.String(""),
+				PoolId:      converter.Int(12),
+			},
+		}).
+		Return(nil, errors.New("AddDeploymentGroup() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "AddDeploymentGroup() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestDeploymentGroup_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceDeploymentGroup()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("3")
+	resourceData.Set(dgProjectID, testDeploymentGroupProjectID.String())
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroup(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetDeploymentGroup() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetDeploymentGroup() Failed")
+}
@@ -0,0 +1,317 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+)
+
+const (
+	epName                  = "name"
+	epServiceEndpointID     = "service_endpoint_id"
+	epServiceEndpointScope  = "service_endpoint_scope"
+	epAzureResourceID       = "azure_resource_id"
+	epDesiredIdle           = "desired_idle"
+	epMaxCapacity           = "max_capacity"
+	epRecycleAfterEachUse   = "recycle_after_each_use"
+	epTimeToLiveMinutes     = "time_to_live_minutes"
+	epOsType                = "os_type"
+	epAgentInteractiveUI    = "agent_interactive_ui"
+	epAutoProvision         = "auto_provision"
+	epAuthorizeAllPipelines = "authorize_all_pipelines"
+	epProjectID             = "project_id"
+)
+
+// ResourceElasticPool schema and implementation for elastic (virtual machine scale set) agent pool resource
+func ResourceElasticPool() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceElasticPoolCreate,
+		Read:          resourceElasticPoolRead,
+		Update:        resourceElasticPoolUpdate,
+		Delete:        resourceAzureAgentPoolDelete,
+		CustomizeDiff: customizeElasticPoolDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			epName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			epServiceEndpointID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			epServiceEndpointScope: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			epAzureResourceID: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+			epDesiredIdle: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			epMaxCapacity: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			epRecycleAfterEachUse: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			epTimeToLiveMinutes: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			epOsType: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(taskagent.OperatingSystemTypeValues.Linux),
+				DiffSuppressFunc: suppress.CaseDifference,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(taskagent.OperatingSystemTypeValues.Linux),
+					string(taskagent.OperatingSystemTypeValues.Windows),
+				}, true),
+			},
+			epAgentInteractiveUI: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			epAutoProvision: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			epAuthorizeAllPipelines: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				ForceNew: true,
+			},
+			epProjectID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func customizeElasticPoolDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	desiredIdle := d.Get(epDesiredIdle).(int)
+	maxCapacity := d.Get(epMaxCapacity).(int)
+	if desiredIdle > maxCapacity {
+		return fmt.Errorf("%s (%d) must not be greater than %s (%d)", epDesiredIdle, desiredIdle, epMaxCapacity, maxCapacity)
+	}
+	return nil
+}
+
+func resourceElasticPoolCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	settings, err := expandElasticPoolSettings(d)
+	if err != nil {
+		return err
+	}
+
+	args := taskagentextras.CreateElasticPoolArgs{
+		ElasticPool: &taskagentextras.ElasticPool{
+			AgentInteractiveUI:   settings.AgentInteractiveUI,
+			AzureId:              settings.AzureId,
+			DesiredIdle:          settings.DesiredIdle,
+			MaxCapacity:          settings.MaxCapacity,
+			OsType:               settings.OsType,
+			RecycleAfterEachUse:  settings.RecycleAfterEachUse,
+			ServiceEndpointId:    settings.ServiceEndpointId,
+			ServiceEndpointScope: settings.ServiceEndpointScope,
+			TimeToLiveMinutes:    settings.TimeToLiveMinutes,
+		},
+		PoolName:                  converter.String(d.Get(epName).(string)),
+		AuthorizeAllPipelines:     converter.Bool(d.Get(epAuthorizeAllPipelines).(bool)),
+		AutoProvisionProjectPools: converter.Bool(d.Get(epAutoProvision).(bool)),
+	}
+	if projectID, ok := d.GetOk(epProjectID); ok {
+		projectUUID, err := uuid.Parse(projectID.(string))
+		if err != nil {
+			return fmt.Errorf(" parsing project ID: %+v", err)
+		}
+		args.ProjectId = &projectUUID
+	}
+
+	result, err := clients.TaskAgentClientExtras.CreateElasticPool(clients.Ctx, args)
+	if err != nil {
+		return fmt.Errorf(" creating elastic pool in Azure DevOps: %+v", err)
+	}
+	if result.AgentPool == nil || result.AgentPool.Id == nil {
+		return fmt.Errorf(" creating elastic pool in Azure DevOps: no agent pool was returned")
+	}
+
+	d.SetId(strconv.Itoa(*result.AgentPool.Id))
+	return resourceElasticPoolRead(d, m)
+}
+
+func resourceElasticPoolRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	poolID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parse elastic pool ID: %+v", err)
+	}
+
+	agentPool, err := clients.TaskAgentClient.GetAgentPool(clients.Ctx, taskagent.GetAgentPoolArgs{PoolId: &poolID})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" looking up Agent Pool with ID %d. Error: %v", poolID, err)
+	}
+	if agentPool == nil || agentPool.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	elasticPool, err := clients.TaskAgentClientExtras.GetElasticPool(clients.Ctx, taskagentextras.GetElasticPoolArgs{PoolId: &poolID})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" looking up Elastic Pool with ID %d. Error: %v", poolID, err)
+	}
+
+	d.Set(epName, agentPool.Name)
+	if agentPool.AutoProvision != nil {
+		d.Set(epAutoProvision, *agentPool.AutoProvision)
+	}
+	flattenElasticPool(d, elasticPool)
+	return nil
+}
+
+func resourceElasticPoolUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	poolID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parse elastic pool ID: %+v", err)
+	}
+
+	if d.HasChange(epName) {
+		_, err := clients.TaskAgentClient.UpdateAgentPool(clients.Ctx, taskagent.UpdateAgentPoolArgs{
+			PoolId: &poolID,
+			Pool: &taskagent.TaskAgentPool{
+				Name: converter.String(d.Get(epName).(string)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf(" updating agent pool of elastic pool in Azure DevOps: %+v", err)
+		}
+	}
+
+	if d.HasChanges(epServiceEndpointID, epServiceEndpointScope, epAzureResourceID, epDesiredIdle, epMaxCapacity,
+		epRecycleAfterEachUse, epTimeToLiveMinutes, epOsType, epAgentInteractiveUI) {
+		settings, err := expandElasticPoolSettings(d)
+		if err != nil {
+			return err
+		}
+
+		_, err = clients.TaskAgentClientExtras.UpdateElasticPool(clients.Ctx, taskagentextras.UpdateElasticPoolArgs{
+			PoolId:              &poolID,
+			ElasticPoolSettings: settings,
+		})
+		if err != nil {
+			return fmt.Errorf(" updating elastic pool in Azure DevOps: %+v", err)
+		}
+	}
+
+	return resourceElasticPoolRead(d, m)
+}
+
+func expandElasticPoolSettings(d *schema.ResourceData) (*taskagentextras.ElasticPoolSettings, error) {
+	serviceEndpointID, err := uuid.Parse(d.Get(epServiceEndpointID).(string))
+	if err != nil {
+		return nil, fmt.Errorf(" parsing service endpoint ID: %+v", err)
+	}
+	serviceEndpointScope, err := uuid.Parse(d.Get(epServiceEndpointScope).(string))
+	if err != nil {
+		return nil, fmt.Errorf(" parsing service endpoint scope: %+v", err)
+	}
+
+	return &taskagentextras.ElasticPoolSettings{
+		AgentInteractiveUI:   converter.Bool(d.Get(epAgentInteractiveUI).(bool)),
+		AzureId:              converter.String(d.Get(epAzureResourceID).(string)),
+		DesiredIdle:          converter.Int(d.Get(epDesiredIdle).(int)),
+		MaxCapacity:          converter.Int(d.Get(epMaxCapacity).(int)),
+		OsType:               converter.ToPtr(taskagent.OperatingSystemType(strings.ToLower(d.Get(epOsType).(string)))),
+		RecycleAfterEachUse:  converter.Bool(d.Get(epRecycleAfterEachUse).(bool)),
+		ServiceEndpointId:    &serviceEndpointID,
+		ServiceEndpointScope: &serviceEndpointScope,
+		TimeToLiveMinutes:    converter.Int(d.Get(epTimeToLiveMinutes).(int)),
+	}, nil
+}
+
+func flattenElasticPool(d *schema.ResourceData, elasticPool *taskagentextras.ElasticPool) {
+	if elasticPool.ServiceEndpointId != nil {
+		d.Set(epServiceEndpointID, elasticPool.ServiceEndpointId.String())
+	}
+	if elasticPool.ServiceEndpointScope != nil {
+		d.Set(epServiceEndpointScope, elasticPool.ServiceEndpointScope.String())
+	}
+	if elasticPool.AzureId != nil {
+		d.Set(epAzureResourceID, *elasticPool.AzureId)
+	}
+	if elasticPool.DesiredIdle != nil {
+		d.Set(epDesiredIdle, *elasticPool.DesiredIdle)
+	}
+	if elasticPool.MaxCapacity != nil {
+		d.Set(epMaxCapacity, *elasticPool.MaxCapacity)
+	}
+	if elasticPool.RecycleAfterEachUse != nil {
+		d.Set(epRecycleAfterEachUse, *elasticPool.RecycleAfterEachUse)
+	}
+	if elasticPool.TimeToLiveMinutes != nil {
+		d.Set(epTimeToLiveMinutes, *elasticPool.TimeToLiveMinutes)
+	}
+	if elasticPool.OsType != nil {
+		d.Set(epOsType, string(*elasticPool.OsType))
+	}
+	if elasticPool.AgentInteractiveUI != nil {
+		d.Set(epAgentInteractiveUI, *elasticPool.AgentInteractiveUI)
+	}
+}
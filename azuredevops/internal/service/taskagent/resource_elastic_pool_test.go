@@ -0,0 +1,155 @@
+//go:build (all || resource_elastic_pool) && !exclude_resource_elastic_pool
+// +build all resource_elastic_pool
+// +build !exclude_resource_elastic_pool
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testElasticPoolServiceEndpointID = uuid.New()
+var testElasticPoolServiceEndpointScope = uuid.New()
+
+var testElasticPool = taskagentextras.ElasticPool{
+	AgentInteractiveUI:   converter.Bool(false),
+	AzureId:              converter.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/virtualMachineScaleSets/vmss"),
+	DesiredIdle:          converter.Int(1),
+	MaxCapacity:          converter.Int(4),
+	OsType:               &taskagent.OperatingSystemTypeValues.Linux,
+	PoolId:               converter.Int(100),
+	RecycleAfterEachUse:  converter.Bool(true),
+	ServiceEndpointId:    &testElasticPoolServiceEndpointID,
+	ServiceEndpointScope: &testElasticPoolServiceEndpointScope,
+	TimeToLiveMinutes:    converter.Int(15),
+}
+
+// verifies that the flatten/expand round trip yields the same elastic pool settings
+func TestElasticPool_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceElasticPool().Schema, nil)
+	flattenElasticPool(resourceData, &testElasticPool)
+
+	settings, err := expandElasticPoolSettings(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, taskagentextras.ElasticPoolSettings{
+		AgentInteractiveUI:   testElasticPool.AgentInteractiveUI,
+		AzureId:              testElasticPool.AzureId,
+		DesiredIdle:          testElasticPool.DesiredIdle,
+		MaxCapacity:          testElasticPool.MaxCapacity,
+		OsType:               testElasticPool.OsType,
+		RecycleAfterEachUse:  testElasticPool.RecycleAfterEachUse,
+		ServiceEndpointId:    testElasticPool.ServiceEndpointId,
+		ServiceEndpointScope: testElasticPool.ServiceEndpointScope,
+		TimeToLiveMinutes:    testElasticPool.TimeToLiveMinutes,
+	}, *settings)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestElasticPool_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceElasticPool()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set(epName, "elastic-pool")
+	flattenElasticPool(resourceData, &testElasticPool)
+
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	taskAgentClientExtras.
+		EXPECT().
+		CreateElasticPool(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("CreateElasticPool() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateElasticPool() Failed")
+}
+
+// verifies that the capacity settings of the remote elastic pool are read into the state
+func TestElasticPool_Read_DetectsCapacityDrift(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceElasticPool()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("100")
+	flattenElasticPool(resourceData, &testElasticPool)
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: taskAgentClientExtras,
+		Ctx:                   context.Background(),
+	}
+
+	poolID := 100
+	taskAgentClient.
+		EXPECT().
+		GetAgentPool(clients.Ctx, taskagent.GetAgentPoolArgs{PoolId: &poolID}).
+		Return(&taskagent.TaskAgentPool{Id: &poolID, Name: converter.String("elastic-pool")}, nil).
+		Times(1)
+
+	remote := testElasticPool
+	remote.DesiredIdle = converter.Int(3)
+	remote.MaxCapacity = converter.Int(10)
+	taskAgentClientExtras.
+		EXPECT().
+		GetElasticPool(clients.Ctx, taskagentextras.GetElasticPoolArgs{PoolId: &poolID}).
+		Return(&remote, nil).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "elastic-pool", resourceData.Get(epName))
+	require.Equal(t, 3, resourceData.Get(epDesiredIdle))
+	require.Equal(t, 10, resourceData.Get(epMaxCapacity))
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestElasticPool_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceElasticPool()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("100")
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: taskAgentClientExtras,
+		Ctx:                   context.Background(),
+	}
+
+	poolID := 100
+	taskAgentClient.
+		EXPECT().
+		GetAgentPool(clients.Ctx, gomock.Any()).
+		Return(&taskagent.TaskAgentPool{Id: &poolID}, nil).
+		Times(1)
+
+	taskAgentClientExtras.
+		EXPECT().
+		GetElasticPool(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetElasticPool() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetElasticPool() Failed")
+}
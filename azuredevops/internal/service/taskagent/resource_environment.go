@@ -2,11 +2,15 @@ package taskagent
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/taskagent/validate"
@@ -16,11 +20,25 @@ import (
 )
 
 const (
-	envProjectId   = "project_id"
-	envName        = "name"
-	envDescription = "description"
+	envProjectId           = "project_id"
+	envName                = "name"
+	envDescription         = "description"
+	envApprovalCheck       = "approval_check"
+	envExclusiveLockCheck  = "exclusive_lock_check"
+	envPipelinePermissions = "pipeline_permissions"
+	envResourceType        = "environment"
 )
 
+var environmentApprovalCheckType = pipelineschecks.CheckType{
+	Id:   converter.UUID("8c6f20a7-a545-4486-9777-f762fafe0d4d"),
+	Name: converter.String("Approval"),
+}
+
+var environmentExclusiveLockCheckType = pipelineschecks.CheckType{
+	Id:   converter.UUID("2ef31ad6-baa0-403a-8b45-2cbc9b4e5563"),
+	Name: converter.String("ExclusiveLock"),
+}
+
 // ResourceEnvironment schema and implementation for environment resource
 func ResourceEnvironment() *schema.Resource {
 	return &schema.Resource{
@@ -46,6 +64,84 @@ func ResourceEnvironment() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
+			envApprovalCheck: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"approvers": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.IsUUID,
+							},
+						},
+						"instructions": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"minimum_required_approvers": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"requester_can_approve": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"approve_in_sequence": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			envExclusiveLockCheck: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			envPipelinePermissions: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"authorize_all_pipelines": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"pipeline_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -63,6 +159,16 @@ func resourceEnvironmentCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	flattenEnvironment(d, createdEnvironment)
+
+	if err := createEnvironmentChecksAndPermissions(d, clients); err != nil {
+		// roll back everything created so far, so that a failed apply does not leave a
+		// partially configured environment behind
+		if rollbackErr := deleteEnvironmentAndChecks(d, clients); rollbackErr != nil {
+			return fmt.Errorf("%+v. Rolling back the environment failed: %+v", err, rollbackErr)
+		}
+		d.SetId("")
+		return err
+	}
 	return resourceEnvironmentRead(d, m)
 }
 
@@ -87,7 +193,11 @@ func resourceEnvironmentRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	flattenEnvironment(d, environment)
-	return nil
+
+	if err := readEnvironmentChecks(d, clients); err != nil {
+		return err
+	}
+	return readEnvironmentPipelinePermissions(d, clients)
 }
 
 func resourceEnvironmentUpdate(d *schema.ResourceData, m interface{}) error {
@@ -102,23 +212,33 @@ func resourceEnvironmentUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Error updating environment in Azure DevOps: %+v", err)
 	}
 
+	if d.HasChange(envApprovalCheck) {
+		if err := updateEnvironmentCheck(d, clients, envApprovalCheck, expandEnvironmentApprovalCheck); err != nil {
+			return err
+		}
+	}
+	if d.HasChange(envExclusiveLockCheck) {
+		if err := updateEnvironmentCheck(d, clients, envExclusiveLockCheck, expandEnvironmentExclusiveLockCheck); err != nil {
+			return err
+		}
+	}
+	if d.HasChange(envPipelinePermissions) {
+		if err := updateEnvironmentPipelinePermissions(d, clients); err != nil {
+			return err
+		}
+	}
+
 	return resourceEnvironmentRead(d, m)
 }
 
 func resourceEnvironmentDelete(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
-	environmentId, err := strconv.Atoi(d.Id())
-	if err != nil {
+	if _, err := strconv.Atoi(d.Id()); err != nil {
 		return fmt.Errorf("Error getting environment id: %+v", err)
 	}
 
-	err = clients.TaskAgentClient.DeleteEnvironment(clients.Ctx, taskagent.DeleteEnvironmentArgs{
-		Project:       converter.String(d.Get(projectID).(string)),
-		EnvironmentId: &environmentId,
-	})
-
-	if err != nil {
-		return fmt.Errorf("Error deleting environment: %+v", err)
+	if err := deleteEnvironmentAndChecks(d, clients); err != nil {
+		return err
 	}
 
 	d.SetId("")
@@ -180,3 +300,357 @@ func flattenEnvironment(d *schema.ResourceData, environment *taskagent.Environme
 	d.Set(envName, *environment.Name)
 	d.Set(envDescription, converter.ToString(environment.Description, ""))
 }
+
+type environmentCheckExpandFunc func(d *schema.ResourceData, values map[string]interface{}) *pipelineschecks.CheckConfiguration
+
+// createEnvironmentChecksAndPermissions creates the checks and pipeline permissions configured for a new environment.
+// The IDs of created checks are recorded immediately, so that they can be rolled back on a later failure.
+func createEnvironmentChecksAndPermissions(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	if err := updateEnvironmentCheck(d, clients, envApprovalCheck, expandEnvironmentApprovalCheck); err != nil {
+		return err
+	}
+	if err := updateEnvironmentCheck(d, clients, envExclusiveLockCheck, expandEnvironmentExclusiveLockCheck); err != nil {
+		return err
+	}
+	return updateEnvironmentPipelinePermissions(d, clients)
+}
+
+// updateEnvironmentCheck adds, updates or removes the check configured by a nested block of the environment
+func updateEnvironmentCheck(d *schema.ResourceData, clients *client.AggregatedClient, key string, expand environmentCheckExpandFunc) error {
+	projectID := d.Get(envProjectId).(string)
+	oldBlock, newBlock := d.GetChange(key)
+	oldValues := getEnvironmentBlock(oldBlock)
+	newValues := getEnvironmentBlock(newBlock)
+	if newValues == nil && isEnvironmentBlockConfigured(d, key) {
+		newValues = map[string]interface{}{}
+	}
+
+	checkID := 0
+	if oldValues != nil {
+		checkID, _ = oldValues["id"].(int)
+	}
+
+	if newValues == nil {
+		if checkID != 0 {
+			if err := deleteEnvironmentCheck(clients, projectID, checkID); err != nil {
+				return err
+			}
+		}
+		return d.Set(key, []interface{}{})
+	}
+
+	configuration := expand(d, newValues)
+	var check *pipelineschecks.CheckConfiguration
+	var err error
+	if checkID != 0 {
+		configuration.Id = &checkID
+		check, err = clients.V5PipelinesChecksClient.UpdateCheckConfiguration(clients.Ctx, pipelineschecks.UpdateCheckConfigurationArgs{
+			Project:       &projectID,
+			Id:            &checkID,
+			Configuration: configuration,
+		})
+	} else {
+		check, err = clients.V5PipelinesChecksClient.AddCheckConfiguration(clients.Ctx, pipelineschecks.AddCheckConfigurationArgs{
+			Project:       &projectID,
+			Configuration: configuration,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("Error configuring %s of environment %s: %+v", key, d.Id(), err)
+	}
+
+	newValues["id"] = converter.ToInt(check.Id, 0)
+	return d.Set(key, []interface{}{newValues})
+}
+
+// deleteEnvironmentAndChecks deletes the checks recorded in the state and the environment itself
+func deleteEnvironmentAndChecks(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	projectID := d.Get(envProjectId).(string)
+	for _, key := range []string{envApprovalCheck, envExclusiveLockCheck} {
+		values := getEnvironmentBlock(d.Get(key))
+		checkID, _ := values["id"].(int)
+		if checkID == 0 {
+			continue
+		}
+		if err := deleteEnvironmentCheck(clients, projectID, checkID); err != nil {
+			return err
+		}
+	}
+
+	environmentID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting environment id: %+v", err)
+	}
+	err = clients.TaskAgentClient.DeleteEnvironment(clients.Ctx, taskagent.DeleteEnvironmentArgs{
+		Project:       converter.String(projectID),
+		EnvironmentId: &environmentID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting environment: %+v", err)
+	}
+	return nil
+}
+
+func deleteEnvironmentCheck(clients *client.AggregatedClient, projectID string, checkID int) error {
+	err := clients.V5PipelinesChecksClient.DeleteCheckConfiguration(clients.Ctx, pipelineschecks.DeleteCheckConfigurationArgs{
+		Project: &projectID,
+		Id:      &checkID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf("Error deleting check %d of environment: %+v", checkID, err)
+	}
+	return nil
+}
+
+// readEnvironmentChecks refreshes the checks managed by the environment. Checks are only read
+// if they are part of the state, checks configured outside of Terraform are left untouched.
+func readEnvironmentChecks(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	projectID := d.Get(envProjectId).(string)
+	for _, key := range []string{envApprovalCheck, envExclusiveLockCheck} {
+		values := getEnvironmentBlock(d.Get(key))
+		checkID, _ := values["id"].(int)
+		if checkID == 0 {
+			continue
+		}
+
+		check, err := clients.V5PipelinesChecksClientExtras.GetCheckConfiguration(clients.Ctx, pipelineschecks.GetCheckConfigurationArgs{
+			Project: &projectID,
+			Id:      &checkID,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) || strings.Contains(err.Error(), "does not exist.") {
+				d.Set(key, []interface{}{})
+				continue
+			}
+			return fmt.Errorf("Error reading %s of environment %s: %+v", key, d.Id(), err)
+		}
+
+		if key == envApprovalCheck {
+			values = flattenEnvironmentApprovalCheck(check)
+		}
+		d.Set(key, []interface{}{values})
+	}
+	return nil
+}
+
+func expandEnvironmentApprovalCheck(d *schema.ResourceData, values map[string]interface{}) *pipelineschecks.CheckConfiguration {
+	approverIDs := tfhelper.ExpandStringSet(values["approvers"].(*schema.Set))
+	sort.Strings(approverIDs)
+	approvers := make([]interface{}, 0, len(approverIDs))
+	for _, approverID := range approverIDs {
+		approvers = append(approvers, map[string]interface{}{"id": approverID})
+	}
+
+	executionOrder := "anyOrder"
+	if values["approve_in_sequence"].(bool) {
+		executionOrder = "inSequence"
+	}
+
+	return &pipelineschecks.CheckConfiguration{
+		Type: &environmentApprovalCheckType,
+		Settings: map[string]interface{}{
+			"approvers":                 approvers,
+			"blockedApprovers":          []interface{}{},
+			"executionOrder":            executionOrder,
+			"instructions":              values["instructions"].(string),
+			"minRequiredApprovers":      values["minimum_required_approvers"].(int),
+			"requesterCannotBeApprover": !values["requester_can_approve"].(bool),
+		},
+		Resource: &pipelineschecks.Resource{
+			Id:   converter.String(d.Id()),
+			Type: converter.String(envResourceType),
+		},
+	}
+}
+
+func flattenEnvironmentApprovalCheck(check *pipelineschecks.CheckConfiguration) map[string]interface{} {
+	values := map[string]interface{}{
+		"id":                         converter.ToInt(check.Id, 0),
+		"approvers":                  []interface{}{},
+		"instructions":               "",
+		"minimum_required_approvers": 0,
+		"requester_can_approve":      false,
+		"approve_in_sequence":        false,
+	}
+
+	settings, ok := check.Settings.(map[string]interface{})
+	if !ok {
+		return values
+	}
+	if approvers, ok := settings["approvers"].([]interface{}); ok {
+		approverIDs := make([]interface{}, 0, len(approvers))
+		for _, approver := range approvers {
+			if approverMap, ok := approver.(map[string]interface{}); ok {
+				if id, ok := approverMap["id"].(string); ok {
+					approverIDs = append(approverIDs, id)
+				}
+			}
+		}
+		values["approvers"] = approverIDs
+	}
+	if instructions, ok := settings["instructions"].(string); ok {
+		values["instructions"] = instructions
+	}
+	switch minRequiredApprovers := settings["minRequiredApprovers"].(type) {
+	case float64:
+		values["minimum_required_approvers"] = int(minRequiredApprovers)
+	case int:
+		values["minimum_required_approvers"] = minRequiredApprovers
+	}
+	if requesterCannotBeApprover, ok := settings["requesterCannotBeApprover"].(bool); ok {
+		values["requester_can_approve"] = !requesterCannotBeApprover
+	}
+	if executionOrder, ok := settings["executionOrder"].(string); ok {
+		values["approve_in_sequence"] = strings.EqualFold(executionOrder, "inSequence")
+	}
+	return values
+}
+
+func expandEnvironmentExclusiveLockCheck(d *schema.ResourceData, _ map[string]interface{}) *pipelineschecks.CheckConfiguration {
+	return &pipelineschecks.CheckConfiguration{
+		Type:     &environmentExclusiveLockCheckType,
+		Settings: map[string]interface{}{},
+		Resource: &pipelineschecks.Resource{
+			Id:   converter.String(d.Id()),
+			Type: converter.String(envResourceType),
+		},
+	}
+}
+
+// updateEnvironmentPipelinePermissions authorizes the environment for all pipelines or the configured
+// pipelines, and revokes the authorization of pipelines which are no longer configured
+func updateEnvironmentPipelinePermissions(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	projectID := d.Get(envProjectId).(string)
+	oldBlock, newBlock := d.GetChange(envPipelinePermissions)
+	oldAll, oldPipelineIDs := expandEnvironmentPipelinePermissions(getEnvironmentBlock(oldBlock))
+	newAll, newPipelineIDs := expandEnvironmentPipelinePermissions(getEnvironmentBlock(newBlock))
+
+	if oldAll != newAll {
+		_, err := updateDefinitionResourceAuth(clients, expandDefinitionResourceReferences(envResourceType, d.Id(), d.Get(envName).(string), newAll), &projectID)
+		if err != nil {
+			return fmt.Errorf("Error updating the pipeline authorization of environment %s: %+v", d.Id(), err)
+		}
+	}
+
+	for _, pipelineID := range newPipelineIDs {
+		if !containsInt(oldPipelineIDs, pipelineID) {
+			if err := authorizeEnvironmentForPipeline(d, clients, pipelineID, true); err != nil {
+				return err
+			}
+		}
+	}
+	for _, pipelineID := range oldPipelineIDs {
+		if !containsInt(newPipelineIDs, pipelineID) {
+			if err := authorizeEnvironmentForPipeline(d, clients, pipelineID, false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func authorizeEnvironmentForPipeline(d *schema.ResourceData, clients *client.AggregatedClient, pipelineID int, authorized bool) error {
+	_, err := clients.BuildClient.AuthorizeDefinitionResources(clients.Ctx, build.AuthorizeDefinitionResourcesArgs{
+		Project:      converter.String(d.Get(envProjectId).(string)),
+		DefinitionId: &pipelineID,
+		Resources: &[]build.DefinitionResourceReference{{
+			Type:       converter.String(envResourceType),
+			Id:         converter.String(d.Id()),
+			Authorized: converter.Bool(authorized),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating the authorization of environment %s for pipeline %d: %+v", d.Id(), pipelineID, err)
+	}
+	return nil
+}
+
+// readEnvironmentPipelinePermissions refreshes the pipeline permissions if they are managed by the environment
+func readEnvironmentPipelinePermissions(d *schema.ResourceData, clients *client.AggregatedClient) error {
+	values := getEnvironmentBlock(d.Get(envPipelinePermissions))
+	if values == nil {
+		return nil
+	}
+
+	projectID := d.Get(envProjectId).(string)
+	projectResources, err := clients.BuildClient.GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+		Project: &projectID,
+		Type:    converter.String(envResourceType),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error reading the pipeline authorization of environment %s: %+v", d.Id(), err)
+	}
+	values["authorize_all_pipelines"] = isDefinitionResourceAuthorized(d.Id(), projectResources)
+
+	_, pipelineIDs := expandEnvironmentPipelinePermissions(values)
+	authorizedPipelineIDs := make([]interface{}, 0, len(pipelineIDs))
+	for _, pipelineID := range pipelineIDs {
+		id := pipelineID
+		definitionResources, err := clients.BuildClient.GetDefinitionResources(clients.Ctx, build.GetDefinitionResourcesArgs{
+			Project:      &projectID,
+			DefinitionId: &id,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("Error reading the resources authorized for pipeline %d: %+v", pipelineID, err)
+		}
+		if isDefinitionResourceAuthorized(d.Id(), definitionResources) {
+			authorizedPipelineIDs = append(authorizedPipelineIDs, pipelineID)
+		}
+	}
+	values["pipeline_ids"] = authorizedPipelineIDs
+	return d.Set(envPipelinePermissions, []interface{}{values})
+}
+
+func expandEnvironmentPipelinePermissions(values map[string]interface{}) (bool, []int) {
+	if values == nil {
+		return false, nil
+	}
+
+	pipelineIDs := []int{}
+	if pipelineSet, ok := values["pipeline_ids"].(*schema.Set); ok {
+		for _, pipelineID := range pipelineSet.List() {
+			pipelineIDs = append(pipelineIDs, pipelineID.(int))
+		}
+	}
+	sort.Ints(pipelineIDs)
+	authorizeAll, _ := values["authorize_all_pipelines"].(bool)
+	return authorizeAll, pipelineIDs
+}
+
+// getEnvironmentBlock returns the values of a nested block with at most one element, or nil if it is not configured.
+// A configured block without any set attribute, like an empty exclusive_lock_check, is read as a nil element and
+// returned as empty values.
+func getEnvironmentBlock(block interface{}) map[string]interface{} {
+	blocks, ok := block.([]interface{})
+	if !ok || len(blocks) == 0 {
+		return nil
+	}
+	if blocks[0] == nil {
+		return map[string]interface{}{}
+	}
+	return blocks[0].(map[string]interface{})
+}
+
+// isEnvironmentBlockConfigured reports whether the raw configuration contains the nested block, which also covers
+// blocks without any user-settable attribute
+func isEnvironmentBlockConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	block := rawConfig.GetAttr(key)
+	return block.IsKnown() && !block.IsNull() && block.LengthInt() > 0
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+package taskagent
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	envResProjectID         = "project_id"
+	envResEnvironmentID     = "environment_id"
+	envResServiceEndpointID = "service_endpoint_id"
+	envResName              = "name"
+	envResClusterName       = "cluster_name"
+	envResNamespace         = "namespace"
+	envResTags              = "tags"
+)
+
+// ResourceEnvironmentKubernetes schema and implementation for kubernetes resources of an environment
+func ResourceEnvironmentKubernetes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentKubernetesCreate,
+		Read:   resourceEnvironmentKubernetesRead,
+		Delete: resourceEnvironmentKubernetesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: importEnvironmentKubernetes,
+		},
+		Schema: map[string]*schema.Schema{
+			envResProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			envResEnvironmentID: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			envResServiceEndpointID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			envResName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			envResClusterName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			envResNamespace: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			envResTags: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentKubernetesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	createParameters, err := expandEnvironmentKubernetes(d)
+	if err != nil {
+		return fmt.Errorf("Error expanding the kubernetes resource from state: %+v", err)
+	}
+
+	kubernetesResource, err := clients.TaskAgentClientExtras.AddKubernetesResource(clients.Ctx, taskagentextras.AddKubernetesResourceArgs{
+		Project:          converter.String(d.Get(envResProjectID).(string)),
+		EnvironmentId:    converter.Int(d.Get(envResEnvironmentID).(int)),
+		CreateParameters: createParameters,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating kubernetes resource in Azure DevOps: %+v", err)
+	}
+
+	d.SetId(strconv.Itoa(*kubernetesResource.Id))
+	return resourceEnvironmentKubernetesRead(d, m)
+}
+
+func resourceEnvironmentKubernetesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	resourceID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting kubernetes resource Id: %+v", err)
+	}
+
+	kubernetesResource, err := clients.TaskAgentClient.GetKubernetesResource(clients.Ctx, taskagent.GetKubernetesResourceArgs{
+		Project:       converter.String(d.Get(envResProjectID).(string)),
+		EnvironmentId: converter.Int(d.Get(envResEnvironmentID).(int)),
+		ResourceId:    &resourceID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading the kubernetes resource: %+v", err)
+	}
+
+	flattenEnvironmentKubernetes(d, kubernetesResource)
+	return nil
+}
+
+func resourceEnvironmentKubernetesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	resourceID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error getting kubernetes resource Id: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteKubernetesResource(clients.Ctx, taskagent.DeleteKubernetesResourceArgs{
+		Project:       converter.String(d.Get(envResProjectID).(string)),
+		EnvironmentId: converter.Int(d.Get(envResEnvironmentID).(int)),
+		ResourceId:    &resourceID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf("Error deleting kubernetes resource: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// importEnvironmentKubernetes imports a kubernetes resource by an ID like <project name or ID>/<environment ID>/<resource ID>
+func importEnvironmentKubernetes(_ context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectNameOrID, environmentID, resourceID, err := parseEnvironmentResourceImportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(projectNameOrID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set(envResProjectID, projectID)
+	d.Set(envResEnvironmentID, environmentID)
+	d.SetId(strconv.Itoa(resourceID))
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseEnvironmentResourceImportID(id string) (string, int, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" {
+		return "", 0, 0, fmt.Errorf("unexpected format of ID (%s), expected project/environmentID/resourceID", id)
+	}
+
+	environmentID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("environment ID was expected to be integer, but was not: %+v", err)
+	}
+	resourceID, err := strconv.Atoi(parts[2])
+	if err != nil {
+		return "", 0, 0, fmt.Errorf("resource ID was expected to be integer, but was not: %+v", err)
+	}
+	return parts[0], environmentID, resourceID, nil
+}
+
+func expandEnvironmentKubernetes(d *schema.ResourceData) (*taskagent.KubernetesResourceCreateParametersExistingEndpoint, error) {
+	serviceEndpointID, err := uuid.Parse(d.Get(envResServiceEndpointID).(string))
+	if err != nil {
+		return nil, fmt.Errorf(" failed to parse service endpoint ID to UUID: %+v", err)
+	}
+
+	createParameters := &taskagent.KubernetesResourceCreateParametersExistingEndpoint{
+		Name:              converter.String(d.Get(envResName).(string)),
+		Namespace:         converter.String(d.Get(envResNamespace).(string)),
+		ServiceEndpointId: &serviceEndpointID,
+	}
+	if clusterName, ok := d.GetOk(envResClusterName); ok {
+		createParameters.ClusterName = converter.String(clusterName.(string))
+	}
+	if tags, ok := d.GetOk(envResTags); ok {
+		tagList := tfhelper.ExpandStringSet(tags.(*schema.Set))
+		createParameters.Tags = &tagList
+	}
+	return createParameters, nil
+}
+
+func flattenEnvironmentKubernetes(d *schema.ResourceData, kubernetesResource *taskagent.KubernetesResource) {
+	d.Set(envResName, converter.ToString(kubernetesResource.Name, ""))
+	d.Set(envResClusterName, converter.ToString(kubernetesResource.ClusterName, ""))
+	d.Set(envResNamespace, converter.ToString(kubernetesResource.Namespace, ""))
+	if kubernetesResource.ServiceEndpointId != nil {
+		d.Set(envResServiceEndpointID, kubernetesResource.ServiceEndpointId.String())
+	}
+	if kubernetesResource.EnvironmentReference != nil && kubernetesResource.EnvironmentReference.Id != nil {
+		d.Set(envResEnvironmentID, *kubernetesResource.EnvironmentReference.Id)
+	}
+	if kubernetesResource.Tags != nil {
+		d.Set(envResTags, *kubernetesResource.Tags)
+	} else {
+		d.Set(envResTags, nil)
+	}
+}
@@ -0,0 +1,117 @@
+//go:build (all || resource_environment_resource_kubernetes) && !exclude_resource_environment_resource_kubernetes
+// +build all resource_environment_resource_kubernetes
+// +build !exclude_resource_environment_resource_kubernetes
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testKubernetesResourceProjectID = uuid.New().String()
+var testKubernetesResourceServiceEndpointID = uuid.New()
+
+var testKubernetesResource = taskagent.KubernetesResource{
+	Id:                   converter.Int(5),
+	Name:                 converter.String("app-namespace"),
+	ClusterName:          converter.String("aks-cluster"),
+	Namespace:            converter.String("app"),
+	ServiceEndpointId:    &testKubernetesResourceServiceEndpointID,
+	Tags:                 &[]string{"web"},
+	EnvironmentReference: &taskagent.EnvironmentReference{Id: converter.Int(10)},
+}
+
+// verifies that the flatten/expand round trip yields the same kubernetes resource
+func TestEnvironmentKubernetes_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	flattenEnvironmentKubernetes(resourceData, &testKubernetesResource)
+
+	createParameters, err := expandEnvironmentKubernetes(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, taskagent.KubernetesResourceCreateParametersExistingEndpoint{
+		Name:              testKubernetesResource.Name,
+		ClusterName:       testKubernetesResource.ClusterName,
+		Namespace:         testKubernetesResource.Namespace,
+		ServiceEndpointId: testKubernetesResource.ServiceEndpointId,
+		Tags:              testKubernetesResource.Tags,
+	}, *createParameters)
+	require.Equal(t, 10, resourceData.Get(envResEnvironmentID))
+}
+
+// verifies that the import ID is parsed into project, environment and resource
+func TestEnvironmentKubernetes_ParseImportID(t *testing.T) {
+	project, environmentID, resourceID, err := parseEnvironmentResourceImportID("project/10/5")
+	require.Nil(t, err)
+	require.Equal(t, "project", project)
+	require.Equal(t, 10, environmentID)
+	require.Equal(t, 5, resourceID)
+
+	_, _, _, err = parseEnvironmentResourceImportID("project/10")
+	require.NotNil(t, err)
+
+	_, _, _, err = parseEnvironmentResourceImportID("project/env/5")
+	require.NotNil(t, err)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestEnvironmentKubernetes_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceEnvironmentKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set(envResProjectID, testKubernetesResourceProjectID)
+	flattenEnvironmentKubernetes(resourceData, &testKubernetesResource)
+
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	taskAgentClientExtras.
+		EXPECT().
+		AddKubernetesResource(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("AddKubernetesResource() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "AddKubernetesResource() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestEnvironmentKubernetes_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceEnvironmentKubernetes()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.SetId("5")
+	resourceData.Set(envResProjectID, testKubernetesResourceProjectID)
+	resourceData.Set(envResEnvironmentID, 10)
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		GetKubernetesResource(clients.Ctx, taskagent.GetKubernetesResourceArgs{
+			Project:       &testKubernetesResourceProjectID,
+			EnvironmentId: converter.Int(10),
+			ResourceId:    converter.Int(5),
+		}).
+		Return(nil, errors.New("GetKubernetesResource() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetKubernetesResource() Failed")
+}
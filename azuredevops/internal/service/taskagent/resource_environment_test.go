@@ -8,11 +8,13 @@ import (
 	"context"
 	"errors"
 	"math/rand"
+	"strconv"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/pipelineschecks"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -148,3 +150,182 @@ func TestEnvironment_UpdateEnvironment_UpdateAndRead(t *testing.T) {
 	require.Equal(t, environmentToUpdate.Description, updatedEnvironment.Description)
 	require.Equal(t, environmentToUpdate.Project.Id, updatedEnvironment.Project.Id)
 }
+
+// verifies that the environment and the checks created so far are rolled back if a check cannot be created
+func TestEnvironment_CreateEnvironment_RollsBackOnCheckFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	checksClient := azdosdkmocks.NewPipelinesChecksClientV5(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:         taskAgentClient,
+		V5PipelinesChecksClient: checksClient,
+		Ctx:                     context.Background(),
+	}
+
+	projectId := testEnvironmentProjectId.String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, map[string]interface{}{
+		envProjectId: projectId,
+		envName:      *testEnvironment.Name,
+		envApprovalCheck: []interface{}{map[string]interface{}{
+			"approvers": []interface{}{uuid.New().String()},
+		}},
+		envExclusiveLockCheck: []interface{}{map[string]interface{}{}},
+	})
+
+	approvalCheckId := 10
+	taskAgentClient.
+		EXPECT().
+		AddEnvironment(clients.Ctx, gomock.Any()).
+		Return(&testEnvironment, nil).
+		Times(1)
+	checksClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, gomock.Any()).
+		Return(&pipelineschecks.CheckConfiguration{Id: &approvalCheckId}, nil).
+		Times(1)
+	checksClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("AddCheckConfiguration() Failed")).
+		Times(1)
+	checksClient.
+		EXPECT().
+		DeleteCheckConfiguration(clients.Ctx, pipelineschecks.DeleteCheckConfigurationArgs{
+			Project: &projectId,
+			Id:      &approvalCheckId,
+		}).
+		Return(nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		DeleteEnvironment(clients.Ctx, taskagent.DeleteEnvironmentArgs{
+			Project:       &projectId,
+			EnvironmentId: &testEnvironmentId,
+		}).
+		Return(nil).
+		Times(1)
+
+	err := resourceEnvironmentCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AddCheckConfiguration() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that deleting an environment removes the checks managed by it
+func TestEnvironment_DeleteEnvironment_DeletesChecks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	checksClient := azdosdkmocks.NewPipelinesChecksClientV5(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:         taskAgentClient,
+		V5PipelinesChecksClient: checksClient,
+		Ctx:                     context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, nil)
+	flattenEnvironment(resourceData, &testEnvironment)
+	lockCheckId := 20
+	resourceData.Set(envExclusiveLockCheck, []interface{}{map[string]interface{}{"id": lockCheckId}})
+
+	projectId := testEnvironmentProjectId.String()
+	checksClient.
+		EXPECT().
+		DeleteCheckConfiguration(clients.Ctx, pipelineschecks.DeleteCheckConfigurationArgs{
+			Project: &projectId,
+			Id:      &lockCheckId,
+		}).
+		Return(nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		DeleteEnvironment(clients.Ctx, taskagent.DeleteEnvironmentArgs{
+			Project:       &projectId,
+			EnvironmentId: &testEnvironmentId,
+		}).
+		Return(nil).
+		Times(1)
+
+	err := resourceEnvironmentDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the settings of an approval check survive the expand/flatten round trip
+func TestEnvironment_ApprovalCheck_ExpandFlatten_Roundtrip(t *testing.T) {
+	approver := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, map[string]interface{}{
+		envApprovalCheck: []interface{}{map[string]interface{}{
+			"approvers":                  []interface{}{approver},
+			"instructions":               "Please approve",
+			"minimum_required_approvers": 1,
+			"requester_can_approve":      true,
+			"approve_in_sequence":        true,
+		}},
+	})
+	flattenEnvironment(resourceData, &testEnvironment)
+
+	values := getEnvironmentBlock(resourceData.Get(envApprovalCheck))
+	check := expandEnvironmentApprovalCheck(resourceData, values)
+	require.Equal(t, environmentApprovalCheckType, *check.Type)
+	require.Equal(t, "environment", *check.Resource.Type)
+	require.Equal(t, resourceData.Id(), *check.Resource.Id)
+	require.Equal(t, false, check.Settings.(map[string]interface{})["requesterCannotBeApprover"])
+
+	checkId := 30
+	check.Id = &checkId
+	flattened := flattenEnvironmentApprovalCheck(check)
+	require.Equal(t, map[string]interface{}{
+		"id":                         checkId,
+		"approvers":                  []interface{}{approver},
+		"instructions":               "Please approve",
+		"minimum_required_approvers": 1,
+		"requester_can_approve":      true,
+		"approve_in_sequence":        true,
+	}, flattened)
+}
+
+// verifies that an empty exclusive_lock_check block, which has no user-settable attribute, creates the check
+func TestEnvironment_CreateEnvironment_EmptyExclusiveLockCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	checksClient := azdosdkmocks.NewPipelinesChecksClientV5(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:         taskAgentClient,
+		V5PipelinesChecksClient: checksClient,
+		Ctx:                     context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, map[string]interface{}{
+		envProjectId:          testEnvironmentProjectId.String(),
+		envName:               *testEnvironment.Name,
+		envExclusiveLockCheck: []interface{}{map[string]interface{}{}},
+	})
+
+	lockCheckId := 20
+	checksClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args pipelineschecks.AddCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error) {
+			require.Equal(t, "ExclusiveLock", *args.Configuration.Type.Name)
+			require.Equal(t, strconv.Itoa(testEnvironmentId), *args.Configuration.Resource.Id)
+			return &pipelineschecks.CheckConfiguration{Id: &lockCheckId}, nil
+		}).
+		Times(1)
+
+	// the checks of a new environment are created once the environment exists
+	flattenEnvironment(resourceData, &testEnvironment)
+	require.Nil(t, createEnvironmentChecksAndPermissions(resourceData, clients))
+	require.Equal(t, lockCheckId, resourceData.Get(envExclusiveLockCheck+".0.id"))
+}
+
+// verifies that a configured block without any set attribute is returned as empty values
+func TestEnvironment_GetEnvironmentBlock_EmptyBlock(t *testing.T) {
+	require.Nil(t, getEnvironmentBlock([]interface{}{}))
+	require.Equal(t, map[string]interface{}{}, getEnvironmentBlock([]interface{}{nil}))
+}
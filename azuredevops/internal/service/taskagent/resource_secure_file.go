@@ -0,0 +1,335 @@
+package taskagent
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	sfProjectID     = "project_id"
+	sfName          = "name"
+	sfFilePath      = "file_path"
+	sfContentBase64 = "content_base64"
+	sfContentHash   = "content_hash"
+	sfProperties    = "properties"
+	sfAllowAccess   = "allow_access"
+)
+
+const (
+	secureFileResourceType                 = "securefile"
+	invalidSecureFileIDErrorMessageFormat  = "Error parsing the secure file ID from the Terraform resource data: %v"
+	flatteningSecureFileErrorMessageFormat = "Error flattening secure file: %v"
+)
+
+// ResourceSecureFile schema and implementation for secure file resource
+func ResourceSecureFile() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceSecureFileCreate,
+		Read:          resourceSecureFileRead,
+		Update:        resourceSecureFileUpdate,
+		Delete:        resourceSecureFileDelete,
+		CustomizeDiff: customizeSecureFileDiff,
+		Importer:      tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			sfProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			sfName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			sfFilePath: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{sfFilePath, sfContentBase64},
+			},
+			sfContentBase64: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsBase64,
+				ExactlyOneOf: []string{sfFilePath, sfContentBase64},
+			},
+			sfContentHash: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			sfProperties: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			sfAllowAccess: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceSecureFileCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(sfProjectID).(string)
+
+	content, err := readSecureFileContent(d)
+	if err != nil {
+		return err
+	}
+
+	secureFile, err := clients.TaskAgentClientExtras.UploadSecureFile(clients.Ctx, taskagentextras.UploadSecureFileArgs{
+		UploadStream: bytes.NewReader(content),
+		Project:      &projectID,
+		Name:         converter.String(d.Get(sfName).(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" uploading secure file in Azure DevOps: %+v", err)
+	}
+	d.SetId(secureFile.Id.String())
+	d.Set(sfContentHash, secureFileContentHash(content))
+
+	// properties can't be set on upload, so they are applied to the uploaded file afterwards
+	if properties := expandSecureFileProperties(d); len(*properties) > 0 {
+		_, err = clients.TaskAgentClientExtras.UpdateSecureFile(clients.Ctx, taskagentextras.UpdateSecureFileArgs{
+			SecureFile: &taskagent.SecureFile{
+				Name:       secureFile.Name,
+				Properties: properties,
+			},
+			Project:      &projectID,
+			SecureFileId: secureFile.Id,
+		})
+		if err != nil {
+			return fmt.Errorf(" updating properties of secure file %s: %+v", secureFile.Id.String(), err)
+		}
+	}
+
+	_, err = updateDefinitionResourceAuth(clients, expandDefinitionResourceReferences(secureFileResourceType, d.Id(), *secureFile.Name, d.Get(sfAllowAccess).(bool)), &projectID)
+	if err != nil {
+		return fmt.Errorf("Error creating definitionResourceReference Azure DevOps object: %+v", err)
+	}
+
+	return resourceSecureFileRead(d, m)
+}
+
+func resourceSecureFileRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(sfProjectID).(string)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(invalidSecureFileIDErrorMessageFormat, err)
+	}
+
+	secureFile, err := clients.TaskAgentClientExtras.GetSecureFile(clients.Ctx, taskagentextras.GetSecureFileArgs{
+		Project:      &projectID,
+		SecureFileId: &secureFileID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up secure file given ID (%v) and project ID (%v): %v", secureFileID, projectID, err)
+	}
+	if secureFile.Id == nil {
+		d.SetId("")
+		return nil
+	}
+
+	err = flattenSecureFile(d, secureFile, projectID)
+	if err != nil {
+		return fmt.Errorf(flatteningSecureFileErrorMessageFormat, err)
+	}
+
+	return readSecureFileAllowAccess(d, clients, projectID)
+}
+
+func resourceSecureFileUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(sfProjectID).(string)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(invalidSecureFileIDErrorMessageFormat, err)
+	}
+
+	if d.HasChanges(sfName, sfProperties) {
+		_, err = clients.TaskAgentClientExtras.UpdateSecureFile(clients.Ctx, taskagentextras.UpdateSecureFileArgs{
+			SecureFile:   expandSecureFile(d),
+			Project:      &projectID,
+			SecureFileId: &secureFileID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating secure file in Azure DevOps: %+v", err)
+		}
+	}
+
+	if d.HasChanges(sfName, sfAllowAccess) {
+		_, err = updateDefinitionResourceAuth(clients, expandDefinitionResourceReferences(secureFileResourceType, d.Id(), d.Get(sfName).(string), d.Get(sfAllowAccess).(bool)), &projectID)
+		if err != nil {
+			return fmt.Errorf("Error updating definitionResourceReference Azure DevOps object: %+v", err)
+		}
+	}
+
+	return resourceSecureFileRead(d, m)
+}
+
+func resourceSecureFileDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(sfProjectID).(string)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(invalidSecureFileIDErrorMessageFormat, err)
+	}
+
+	_, err = updateDefinitionResourceAuth(clients, expandDefinitionResourceReferences(secureFileResourceType, d.Id(), "", false), &projectID)
+	if err != nil {
+		return fmt.Errorf("Error deleting the allow access definitionResource for secure file ID (%v) and project ID (%v): %v", secureFileID, projectID, err)
+	}
+
+	err = clients.TaskAgentClientExtras.DeleteSecureFile(clients.Ctx, taskagentextras.DeleteSecureFileArgs{
+		Project:      &projectID,
+		SecureFileId: &secureFileID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting secure file: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func readSecureFileAllowAccess(d *schema.ResourceData, clients *client.AggregatedClient, projectID string) error {
+	projectResources, err := clients.BuildClient.GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+		Project: &projectID,
+		Type:    converter.String(secureFileResourceType),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up project resources given ID (%v) and project ID (%v): %v", d.Id(), projectID, err)
+	}
+
+	d.Set(sfAllowAccess, isDefinitionResourceAuthorized(d.Id(), projectResources))
+	return nil
+}
+
+// customizeSecureFileDiff forces a new secure file when the configured content no longer matches the
+// content hash recorded in the state, as the content of an uploaded secure file can't be changed.
+func customizeSecureFileDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown(sfFilePath) || !d.NewValueKnown(sfContentBase64) {
+		return d.SetNewComputed(sfContentHash)
+	}
+
+	content, err := readSecureFileContentFromConfig(d.Get(sfFilePath).(string), d.Get(sfContentBase64).(string))
+	if err != nil {
+		// the file may be generated by another resource during the apply
+		return d.SetNewComputed(sfContentHash)
+	}
+
+	oldHashRaw, _ := d.GetChange(sfContentHash)
+	oldHash := oldHashRaw.(string)
+	newHash := secureFileContentHash(content)
+	if oldHash == newHash {
+		return nil
+	}
+	if err := d.SetNew(sfContentHash, newHash); err != nil {
+		return err
+	}
+	// an imported secure file has no recorded hash, so its content is adopted as is
+	if oldHash != "" && d.Id() != "" {
+		return d.ForceNew(sfContentHash)
+	}
+	return nil
+}
+
+func readSecureFileContent(d *schema.ResourceData) ([]byte, error) {
+	return readSecureFileContentFromConfig(d.Get(sfFilePath).(string), d.Get(sfContentBase64).(string))
+}
+
+func readSecureFileContentFromConfig(filePath string, contentBase64 string) ([]byte, error) {
+	if filePath != "" {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf(" reading secure file content from %s: %+v", filePath, err)
+		}
+		return content, nil
+	}
+
+	content, err := base64.StdEncoding.DecodeString(contentBase64)
+	if err != nil {
+		return nil, fmt.Errorf(" decoding base64 secure file content: %+v", err)
+	}
+	return content, nil
+}
+
+func secureFileContentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func expandSecureFile(d *schema.ResourceData) *taskagent.SecureFile {
+	secureFile := &taskagent.SecureFile{
+		Name:       converter.String(d.Get(sfName).(string)),
+		Properties: expandSecureFileProperties(d),
+	}
+	if id, err := uuid.Parse(d.Id()); err == nil {
+		secureFile.Id = &id
+	}
+	return secureFile
+}
+
+func expandSecureFileProperties(d *schema.ResourceData) *map[string]string {
+	properties := map[string]string{}
+	for k, v := range d.Get(sfProperties).(map[string]interface{}) {
+		properties[k] = v.(string)
+	}
+	return &properties
+}
+
+func flattenSecureFile(d *schema.ResourceData, secureFile *taskagent.SecureFile, projectID string) error {
+	d.SetId(secureFile.Id.String())
+	d.Set(sfProjectID, projectID)
+	d.Set(sfName, converter.ToString(secureFile.Name, ""))
+
+	properties := map[string]string{}
+	if secureFile.Properties != nil {
+		properties = *secureFile.Properties
+	}
+	return d.Set(sfProperties, properties)
+}
+
+func isDefinitionResourceAuthorized(resourceID string, definitionResource *[]build.DefinitionResourceReference) bool {
+	if definitionResource == nil {
+		return false
+	}
+	for _, authResource := range *definitionResource {
+		if authResource.Id != nil && resourceID == *authResource.Id {
+			return converter.ToBool(authResource.Authorized, false)
+		}
+	}
+	return false
+}
@@ -0,0 +1,140 @@
+//go:build (all || resource_secure_file) && !exclude_resource_secure_file
+// +build all resource_secure_file
+// +build !exclude_resource_secure_file
+
+package taskagent
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecureFileProjectID = uuid.New().String()
+var testSecureFileID = uuid.New()
+
+var testSecureFile = taskagent.SecureFile{
+	Id:   &testSecureFileID,
+	Name: converter.String("signing.p12"),
+	Properties: &map[string]string{
+		"owner": "mobile",
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same secure file
+func TestSecureFile_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceSecureFile().Schema, nil)
+	err := flattenSecureFile(resourceData, &testSecureFile, testSecureFileProjectID)
+	require.Nil(t, err)
+
+	secureFileAfterRoundTrip := expandSecureFile(resourceData)
+	require.Equal(t, testSecureFile, *secureFileAfterRoundTrip)
+	require.Equal(t, testSecureFileProjectID, resourceData.Get(sfProjectID))
+}
+
+// verifies that the content is read from either the local file or the base64 value
+func TestSecureFile_ReadContent(t *testing.T) {
+	content := []byte("secure content")
+	filePath := filepath.Join(t.TempDir(), "secure.txt")
+	require.Nil(t, os.WriteFile(filePath, content, 0600))
+
+	fromFile, err := readSecureFileContentFromConfig(filePath, "")
+	require.Nil(t, err)
+	require.Equal(t, content, fromFile)
+
+	fromBase64, err := readSecureFileContentFromConfig("", base64.StdEncoding.EncodeToString(content))
+	require.Nil(t, err)
+	require.Equal(t, content, fromBase64)
+
+	require.Equal(t, secureFileContentHash(fromFile), secureFileContentHash(fromBase64))
+
+	_, err = readSecureFileContentFromConfig(filepath.Join(t.TempDir(), "missing.txt"), "")
+	require.NotNil(t, err)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestSecureFile_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceSecureFile()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set(sfProjectID, testSecureFileProjectID)
+	resourceData.Set(sfName, *testSecureFile.Name)
+	resourceData.Set(sfContentBase64, base64.StdEncoding.EncodeToString([]byte("content")))
+
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	taskAgentClientExtras.
+		EXPECT().
+		UploadSecureFile(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("UploadSecureFile() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "UploadSecureFile() Failed")
+}
+
+// verifies that if an error is produced on a read, it is not swallowed
+func TestSecureFile_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceSecureFile()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	flattenSecureFile(resourceData, &testSecureFile, testSecureFileProjectID)
+
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	expectedArgs := taskagentextras.GetSecureFileArgs{
+		Project:      &testSecureFileProjectID,
+		SecureFileId: &testSecureFileID,
+	}
+	taskAgentClientExtras.
+		EXPECT().
+		GetSecureFile(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("GetSecureFile() Failed")).
+		Times(1)
+
+	err := r.Read(resourceData, clients)
+	require.Contains(t, err.Error(), "GetSecureFile() Failed")
+}
+
+// verifies that the data source reports secure files that can't be found
+func TestDataSourceSecureFile_Read_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClientExtras := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClientExtras: taskAgentClientExtras, Ctx: context.Background()}
+
+	name := "signing"
+	taskAgentClientExtras.
+		EXPECT().
+		GetSecureFiles(clients.Ctx, taskagentextras.GetSecureFilesArgs{
+			Project:     &testSecureFileProjectID,
+			NamePattern: &name,
+		}).
+		Return(&[]taskagent.SecureFile{testSecureFile}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataSecureFile().Schema, nil)
+	resourceData.Set(sfProjectID, testSecureFileProjectID)
+	resourceData.Set(sfName, name)
+	err := dataSourceSecureFileRead(resourceData, clients)
+	require.Contains(t, err.Error(), "Unable to find secure file")
+}
@@ -0,0 +1,669 @@
+package taskagent
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	tgProjectID          = "project_id"
+	tgName               = "name"
+	tgDescription        = "description"
+	tgCategory           = "category"
+	tgInstanceNameFormat = "instance_name_format"
+	tgRunsOn             = "runs_on"
+	tgInput              = "input"
+	tgTask               = "task"
+	tgParentTaskGroupID  = "parent_task_group_id"
+	tgPreview            = "preview"
+	tgPublish            = "publish"
+	tgVersion            = "version"
+	tgRevision           = "revision"
+)
+
+var taskGroupCategories = []string{"Build", "Deploy", "Package", "Test", "Tool", "Utility"}
+
+// ResourceTaskGroup schema and implementation for task group resource
+func ResourceTaskGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceTaskGroupCreate,
+		Read:     resourceTaskGroupRead,
+		Update:   resourceTaskGroupUpdate,
+		Delete:   resourceTaskGroupDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			tgProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			tgName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			tgDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			tgCategory: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Build",
+				ValidateFunc: validation.StringInSlice(taskGroupCategories, false),
+			},
+			tgInstanceNameFormat: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			tgRunsOn: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Agent", "DeploymentGroup", "Server"}, false),
+				},
+			},
+			tgInput: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "string",
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"help_markdown": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			tgTask: {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"version": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"definition_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "task",
+							ValidateFunc: validation.StringInSlice([]string{"task", "metaTask"}, false),
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"inputs": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"condition": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "succeeded()",
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"continue_on_error": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+					},
+				},
+			},
+			tgParentTaskGroupID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			tgPreview: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			tgPublish: {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				ForceNew:     true,
+				RequiredWith: []string{tgParentTaskGroupID},
+			},
+			tgVersion: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			tgRevision: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTaskGroupCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(tgProjectID).(string)
+
+	isDraft := d.Get(tgParentTaskGroupID).(string) != ""
+	if isDraft && d.Get(tgPreview).(bool) && !d.Get(tgPublish).(bool) {
+		return fmt.Errorf("A draft of a task group can only be published as preview, set publish to publish the draft")
+	}
+	if d.Get(tgPublish).(bool) {
+		if err := publishTaskGroupDraftFromConfig(d, clients, projectID); err != nil {
+			return err
+		}
+		return resourceTaskGroupRead(d, m)
+	}
+
+	createParameter := expandTaskGroup(d)
+	if isDraft {
+		// a task group with a parent is a draft of the parent task group
+		createParameter.ParentDefinitionId = converter.UUID(d.Get(tgParentTaskGroupID).(string))
+	}
+
+	taskGroup, err := clients.TaskAgentClient.AddTaskGroup(clients.Ctx, taskagent.AddTaskGroupArgs{
+		Project:   &projectID,
+		TaskGroup: createParameter,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating task group in Azure DevOps: %+v", err)
+	}
+	d.SetId(taskGroup.Id.String())
+
+	if !isDraft && d.Get(tgPreview).(bool) {
+		if err := publishPreviewTaskGroup(clients, projectID, taskGroup, true); err != nil {
+			return err
+		}
+	}
+	return resourceTaskGroupRead(d, m)
+}
+
+func resourceTaskGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(tgProjectID).(string)
+
+	if d.Get(tgPublish).(bool) {
+		return readPublishedTaskGroupDraft(d, clients, projectID)
+	}
+
+	taskGroup, err := getLatestTaskGroupVersion(clients, projectID, d.Id())
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading task group %s: %+v", d.Id(), err)
+	}
+	if taskGroup == nil {
+		d.SetId("")
+		return nil
+	}
+
+	return flattenTaskGroup(d, taskGroup)
+}
+
+func resourceTaskGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(tgProjectID).(string)
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the task group ID: %+v", err)
+	}
+
+	if d.Get(tgPublish).(bool) {
+		// a published draft is merged into its parent, changes are published as another draft
+		if d.HasChanges(tgName, tgDescription, tgCategory, tgInstanceNameFormat, tgRunsOn, tgInput, tgTask) {
+			if err := publishTaskGroupDraftFromConfig(d, clients, projectID); err != nil {
+				return err
+			}
+		} else if d.HasChange(tgPreview) {
+			// only the preview state of the published version of the parent task group changes
+			parentID := d.Get(tgParentTaskGroupID).(string)
+			parent, err := getLatestTaskGroupVersion(clients, projectID, parentID)
+			if err != nil {
+				return fmt.Errorf("Error reading task group %s: %+v", parentID, err)
+			}
+			if parent == nil {
+				return fmt.Errorf("Task group %s does not exist", parentID)
+			}
+			if err := publishPreviewTaskGroup(clients, projectID, parent, d.Get(tgPreview).(bool)); err != nil {
+				return err
+			}
+		}
+		return resourceTaskGroupRead(d, m)
+	}
+
+	current, err := getLatestTaskGroupVersion(clients, projectID, d.Id())
+	if err != nil {
+		return fmt.Errorf("Error reading task group %s: %+v", d.Id(), err)
+	}
+	if current == nil {
+		return fmt.Errorf("Task group %s does not exist", d.Id())
+	}
+
+	isDraft := d.Get(tgParentTaskGroupID).(string) != ""
+	preview := d.Get(tgPreview).(bool)
+	if d.HasChanges(tgName, tgDescription, tgCategory, tgInstanceNameFormat, tgRunsOn, tgInput, tgTask) {
+		if preview && !isDraft {
+			// changes to a task group in preview are published as a new preview version, the
+			// current version stays available to the definitions which still use it
+			return publishTaskGroupPreviewVersion(d, m, clients, projectID, &taskGroupID)
+		}
+
+		createParameter := expandTaskGroup(d)
+		updated, err := clients.TaskAgentClient.UpdateTaskGroup(clients.Ctx, taskagent.UpdateTaskGroupArgs{
+			Project:     &projectID,
+			TaskGroupId: &taskGroupID,
+			TaskGroup: &taskagent.TaskGroupUpdateParameter{
+				Id:                 &taskGroupID,
+				Name:               createParameter.Name,
+				Description:        createParameter.Description,
+				Category:           createParameter.Category,
+				InstanceNameFormat: createParameter.InstanceNameFormat,
+				RunsOn:             createParameter.RunsOn,
+				Inputs:             createParameter.Inputs,
+				Tasks:              createParameter.Tasks,
+				ParentDefinitionId: current.ParentDefinitionId,
+				Revision:           current.Revision,
+				Version:            current.Version,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating task group in Azure DevOps: %+v", err)
+		}
+		current = updated
+	}
+
+	if d.HasChange(tgPreview) && !isDraft {
+		if err := publishPreviewTaskGroup(clients, projectID, current, preview); err != nil {
+			return err
+		}
+	}
+	return resourceTaskGroupRead(d, m)
+}
+
+func resourceTaskGroupDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	if d.Get(tgPublish).(bool) {
+		// the published version belongs to the parent task group and is not removed
+		d.SetId("")
+		return nil
+	}
+
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf("Error parsing the task group ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteTaskGroup(clients.Ctx, taskagent.DeleteTaskGroupArgs{
+		Project:     converter.String(d.Get(tgProjectID).(string)),
+		TaskGroupId: &taskGroupID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf("Error deleting task group: %+v", err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// publishTaskGroupPreviewVersion saves the configuration as a draft of the task group and publishes
+// the draft as a new major version in preview
+func publishTaskGroupPreviewVersion(d *schema.ResourceData, m interface{}, clients *client.AggregatedClient, projectID string, taskGroupID *uuid.UUID) error {
+	createParameter := expandTaskGroup(d)
+	createParameter.ParentDefinitionId = taskGroupID
+	draft, err := clients.TaskAgentClient.AddTaskGroup(clients.Ctx, taskagent.AddTaskGroupArgs{
+		Project:   &projectID,
+		TaskGroup: createParameter,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating a draft of task group %s: %+v", taskGroupID.String(), err)
+	}
+
+	if err := publishTaskGroupDraft(clients, projectID, taskGroupID, draft, true); err != nil {
+		return err
+	}
+	return resourceTaskGroupRead(d, m)
+}
+
+// publishTaskGroupDraftFromConfig creates a draft of the parent task group from the configuration and publishes it
+// as a new major version of the parent task group. The resource keeps the ID of the published draft.
+func publishTaskGroupDraftFromConfig(d *schema.ResourceData, clients *client.AggregatedClient, projectID string) error {
+	parentID, err := uuid.Parse(d.Get(tgParentTaskGroupID).(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing the parent task group ID: %+v", err)
+	}
+
+	createParameter := expandTaskGroup(d)
+	createParameter.ParentDefinitionId = &parentID
+	draft, err := clients.TaskAgentClient.AddTaskGroup(clients.Ctx, taskagent.AddTaskGroupArgs{
+		Project:   &projectID,
+		TaskGroup: createParameter,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating a draft of task group %s: %+v", parentID.String(), err)
+	}
+	d.SetId(draft.Id.String())
+
+	return publishTaskGroupDraft(clients, projectID, &parentID, draft, d.Get(tgPreview).(bool))
+}
+
+// publishTaskGroupDraft publishes the draft as a new major version of the parent task group
+func publishTaskGroupDraft(clients *client.AggregatedClient, projectID string, parentID *uuid.UUID, draft *taskagent.TaskGroup, preview bool) error {
+	_, err := clients.TaskAgentClientExtras.PublishTaskGroup(clients.Ctx, taskagentextras.PublishTaskGroupArgs{
+		Project:           &projectID,
+		ParentTaskGroupId: parentID,
+		TaskGroupPublishParameter: &taskagentextras.TaskGroupPublishParameter{
+			Preview:           &preview,
+			TaskGroupId:       draft.Id,
+			TaskGroupRevision: draft.Revision,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error publishing a new version of task group %s: %+v", parentID.String(), err)
+	}
+	return nil
+}
+
+// readPublishedTaskGroupDraft reads the version of the parent task group of a published draft. The draft itself is
+// merged into the parent task group when it is published, so its content is kept from the configuration.
+func readPublishedTaskGroupDraft(d *schema.ResourceData, clients *client.AggregatedClient, projectID string) error {
+	parentID := d.Get(tgParentTaskGroupID).(string)
+	parent, err := getLatestTaskGroupVersion(clients, projectID, parentID)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error reading task group %s: %+v", parentID, err)
+	}
+	if parent == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set(tgPreview, converter.ToBool(parent.Preview, false))
+	d.Set(tgVersion, taskGroupMajorVersion(parent))
+	d.Set(tgRevision, converter.ToInt(parent.Revision, 0))
+	return nil
+}
+
+// publishPreviewTaskGroup marks the given version of a task group as preview, or publishes a preview version
+func publishPreviewTaskGroup(clients *client.AggregatedClient, projectID string, taskGroup *taskagent.TaskGroup, preview bool) error {
+	_, err := clients.TaskAgentClientExtras.PublishPreviewTaskGroup(clients.Ctx, taskagentextras.PublishPreviewTaskGroupArgs{
+		Project:     &projectID,
+		TaskGroupId: taskGroup.Id,
+		TaskGroup: &taskagent.TaskGroupPublishPreviewParameter{
+			Preview:  &preview,
+			Revision: taskGroup.Revision,
+			Version:  taskGroup.Version,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating the preview state of task group %s: %+v", taskGroup.Id.String(), err)
+	}
+	return nil
+}
+
+// getLatestTaskGroupVersion returns the most recent major version of a task group. Every major
+// version of a task group shares the same ID, so the API returns all of them.
+func getLatestTaskGroupVersion(clients *client.AggregatedClient, projectID string, taskGroupID string) (*taskagent.TaskGroup, error) {
+	id, err := uuid.Parse(taskGroupID)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the task group ID: %+v", err)
+	}
+
+	taskGroups, err := clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+		Project:     &projectID,
+		TaskGroupId: &id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return latestTaskGroupVersion(taskGroups, func(taskGroup *taskagent.TaskGroup) bool { return true }), nil
+}
+
+// latestTaskGroupVersion returns the not deleted task group with the highest major version that matches the filter
+func latestTaskGroupVersion(taskGroups *[]taskagent.TaskGroup, filter func(*taskagent.TaskGroup) bool) *taskagent.TaskGroup {
+	if taskGroups == nil {
+		return nil
+	}
+
+	var latest *taskagent.TaskGroup
+	for i := range *taskGroups {
+		taskGroup := &(*taskGroups)[i]
+		if taskGroup.Id == nil || converter.ToBool(taskGroup.Deleted, false) || !filter(taskGroup) {
+			continue
+		}
+		if latest == nil || taskGroupMajorVersion(taskGroup) > taskGroupMajorVersion(latest) {
+			latest = taskGroup
+		}
+	}
+	return latest
+}
+
+func taskGroupMajorVersion(taskGroup *taskagent.TaskGroup) int {
+	if taskGroup.Version == nil {
+		return 0
+	}
+	return converter.ToInt(taskGroup.Version.Major, 0)
+}
+
+func expandTaskGroup(d *schema.ResourceData) *taskagent.TaskGroupCreateParameter {
+	name := d.Get(tgName).(string)
+
+	instanceNameFormat := d.Get(tgInstanceNameFormat).(string)
+	if instanceNameFormat == "" {
+		instanceNameFormat = fmt.Sprintf("Task group: %s", name)
+	}
+
+	runsOn := tfhelper.ExpandStringSet(d.Get(tgRunsOn).(*schema.Set))
+	if len(runsOn) == 0 {
+		runsOn = []string{"Agent", "DeploymentGroup"}
+	}
+	sort.Strings(runsOn)
+
+	return &taskagent.TaskGroupCreateParameter{
+		Name:               &name,
+		Description:        converter.String(d.Get(tgDescription).(string)),
+		Category:           converter.String(d.Get(tgCategory).(string)),
+		InstanceNameFormat: &instanceNameFormat,
+		RunsOn:             &runsOn,
+		Inputs:             expandTaskGroupInputs(d.Get(tgInput).([]interface{})),
+		Tasks:              expandTaskGroupSteps(d.Get(tgTask).([]interface{})),
+	}
+}
+
+func expandTaskGroupInputs(configured []interface{}) *[]taskagent.TaskInputDefinition {
+	inputs := make([]taskagent.TaskInputDefinition, 0, len(configured))
+	for _, raw := range configured {
+		values := raw.(map[string]interface{})
+		name := values["name"].(string)
+		label := values["label"].(string)
+		if label == "" {
+			label = name
+		}
+		inputs = append(inputs, taskagent.TaskInputDefinition{
+			Name:         &name,
+			Label:        &label,
+			Type:         converter.String(values["type"].(string)),
+			DefaultValue: converter.String(values["default_value"].(string)),
+			Required:     converter.Bool(values["required"].(bool)),
+			HelpMarkDown: converter.String(values["help_markdown"].(string)),
+		})
+	}
+	return &inputs
+}
+
+func expandTaskGroupSteps(configured []interface{}) *[]taskagent.TaskGroupStep {
+	steps := make([]taskagent.TaskGroupStep, 0, len(configured))
+	for _, raw := range configured {
+		values := raw.(map[string]interface{})
+
+		inputs := map[string]string{}
+		if configuredInputs, ok := values["inputs"].(map[string]interface{}); ok {
+			for key, value := range configuredInputs {
+				inputs[key] = value.(string)
+			}
+		}
+
+		steps = append(steps, taskagent.TaskGroupStep{
+			Task: &taskagent.TaskDefinitionReference{
+				Id:             converter.UUID(values["task_id"].(string)),
+				VersionSpec:    converter.String(values["version"].(string)),
+				DefinitionType: converter.String(values["definition_type"].(string)),
+			},
+			DisplayName:      converter.String(values["display_name"].(string)),
+			Inputs:           &inputs,
+			Condition:        converter.String(values["condition"].(string)),
+			Enabled:          converter.Bool(values["enabled"].(bool)),
+			ContinueOnError:  converter.Bool(values["continue_on_error"].(bool)),
+			TimeoutInMinutes: converter.Int(values["timeout_in_minutes"].(int)),
+		})
+	}
+	return &steps
+}
+
+func flattenTaskGroup(d *schema.ResourceData, taskGroup *taskagent.TaskGroup) error {
+	d.Set(tgName, converter.ToString(taskGroup.Name, ""))
+	d.Set(tgDescription, converter.ToString(taskGroup.Description, ""))
+	d.Set(tgCategory, converter.ToString(taskGroup.Category, ""))
+	d.Set(tgInstanceNameFormat, converter.ToString(taskGroup.InstanceNameFormat, ""))
+	d.Set(tgPreview, converter.ToBool(taskGroup.Preview, false))
+	d.Set(tgVersion, taskGroupMajorVersion(taskGroup))
+	d.Set(tgRevision, converter.ToInt(taskGroup.Revision, 0))
+	if taskGroup.ParentDefinitionId != nil {
+		d.Set(tgParentTaskGroupID, taskGroup.ParentDefinitionId.String())
+	}
+
+	runsOn := []string{}
+	if taskGroup.RunsOn != nil {
+		runsOn = append(runsOn, *taskGroup.RunsOn...)
+	}
+	if err := d.Set(tgRunsOn, runsOn); err != nil {
+		return fmt.Errorf("Error setting runs_on of task group: %+v", err)
+	}
+	if err := d.Set(tgInput, flattenTaskGroupInputs(taskGroup.Inputs)); err != nil {
+		return fmt.Errorf("Error setting input of task group: %+v", err)
+	}
+	if err := d.Set(tgTask, flattenTaskGroupSteps(taskGroup.Tasks)); err != nil {
+		return fmt.Errorf("Error setting task of task group: %+v", err)
+	}
+	return nil
+}
+
+func flattenTaskGroupInputs(inputs *[]taskagent.TaskInputDefinition) []interface{} {
+	results := make([]interface{}, 0)
+	if inputs == nil {
+		return results
+	}
+
+	for _, input := range *inputs {
+		results = append(results, map[string]interface{}{
+			"name":          converter.ToString(input.Name, ""),
+			"label":         converter.ToString(input.Label, ""),
+			"type":          converter.ToString(input.Type, ""),
+			"default_value": converter.ToString(input.DefaultValue, ""),
+			"required":      converter.ToBool(input.Required, false),
+			"help_markdown": converter.ToString(input.HelpMarkDown, ""),
+		})
+	}
+	return results
+}
+
+func flattenTaskGroupSteps(steps *[]taskagent.TaskGroupStep) []interface{} {
+	results := make([]interface{}, 0)
+	if steps == nil {
+		return results
+	}
+
+	for _, step := range *steps {
+		result := map[string]interface{}{
+			"display_name":       converter.ToString(step.DisplayName, ""),
+			"condition":          converter.ToString(step.Condition, ""),
+			"enabled":            converter.ToBool(step.Enabled, true),
+			"continue_on_error":  converter.ToBool(step.ContinueOnError, false),
+			"timeout_in_minutes": converter.ToInt(step.TimeoutInMinutes, 0),
+		}
+		if step.Task != nil {
+			if step.Task.Id != nil {
+				result["task_id"] = step.Task.Id.String()
+			}
+			result["version"] = converter.ToString(step.Task.VersionSpec, "")
+			result["definition_type"] = converter.ToString(step.Task.DefinitionType, "task")
+		}
+
+		inputs := map[string]interface{}{}
+		if step.Inputs != nil {
+			for key, value := range *step.Inputs {
+				inputs[key] = value
+			}
+		}
+		result["inputs"] = inputs
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,359 @@
+//go:build (all || resource_task_group) && !exclude_resource_task_group
+// +build all resource_task_group
+// +build !exclude_resource_task_group
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/taskagentextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testTaskGroupProjectID = uuid.New().String()
+var testTaskGroupID = uuid.New()
+var testTaskGroupTaskID = uuid.New()
+
+func getTaskGroupResourceData(t *testing.T, preview bool) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, getTaskGroupConfig(preview))
+}
+
+func getTaskGroupConfig(preview bool) map[string]interface{} {
+	return map[string]interface{}{
+		tgProjectID: testTaskGroupProjectID,
+		tgName:      "Deploy Web App",
+		tgPreview:   preview,
+		tgInput: []interface{}{map[string]interface{}{
+			"name":          "environment",
+			"default_value": "dev",
+			"required":      true,
+		}},
+		tgTask: []interface{}{map[string]interface{}{
+			"task_id": testTaskGroupTaskID.String(),
+			"version": "2.*",
+			"inputs": map[string]interface{}{
+				"script": "echo $(environment)",
+			},
+		}},
+	}
+}
+
+func newTestTaskGroup(major int, preview bool) taskagent.TaskGroup {
+	return taskagent.TaskGroup{
+		Id:       &testTaskGroupID,
+		Name:     converter.String("Deploy Web App"),
+		Preview:  converter.Bool(preview),
+		Revision: converter.Int(major * 10),
+		Version:  &taskagent.TaskVersion{Major: converter.Int(major)},
+	}
+}
+
+// verifies that the flatten/expand round trip yields the same inputs and tasks
+func TestTaskGroup_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := getTaskGroupResourceData(t, false)
+	createParameter := expandTaskGroup(resourceData)
+	require.Equal(t, "Task group: Deploy Web App", *createParameter.InstanceNameFormat)
+	require.Equal(t, []string{"Agent", "DeploymentGroup"}, *createParameter.RunsOn)
+	require.Equal(t, "environment", *(*createParameter.Inputs)[0].Label)
+	require.Equal(t, "task", *(*createParameter.Tasks)[0].Task.DefinitionType)
+
+	taskGroup := newTestTaskGroup(1, false)
+	taskGroup.Inputs = createParameter.Inputs
+	taskGroup.Tasks = createParameter.Tasks
+
+	flattenedData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	require.Nil(t, flattenTaskGroup(flattenedData, &taskGroup))
+	// the label of an input defaults to its name
+	flattenedInput := flattenedData.Get(tgInput).([]interface{})[0].(map[string]interface{})
+	require.Equal(t, "environment", flattenedInput["label"])
+	require.Equal(t, "dev", flattenedInput["default_value"])
+	require.Equal(t, true, flattenedInput["required"])
+	require.Equal(t, resourceData.Get(tgTask), flattenedData.Get(tgTask))
+}
+
+// verifies that the read operation uses the most recent version of the task group
+func TestTaskGroup_Read_UsesLatestVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getTaskGroupResourceData(t, false)
+	resourceData.SetId(testTaskGroupID.String())
+
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+			Project:     &testTaskGroupProjectID,
+			TaskGroupId: &testTaskGroupID,
+		}).
+		Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, false), newTestTaskGroup(2, true)}, nil).
+		Times(1)
+
+	err := resourceTaskGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 2, resourceData.Get(tgVersion))
+	require.Equal(t, 20, resourceData.Get(tgRevision))
+	require.True(t, resourceData.Get(tgPreview).(bool))
+}
+
+// verifies that the create operation is considered failed if the API call fails
+func TestTaskGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	taskAgentClient.
+		EXPECT().
+		AddTaskGroup(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("AddTaskGroup() Failed")).
+		Times(1)
+
+	err := resourceTaskGroupCreate(getTaskGroupResourceData(t, false), clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AddTaskGroup() Failed")
+}
+
+// verifies that a preview task group is created as preview version
+func TestTaskGroup_Create_PublishesPreview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	extrasClient := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: extrasClient,
+		Ctx:                   context.Background(),
+	}
+
+	created := newTestTaskGroup(1, false)
+	taskAgentClient.
+		EXPECT().
+		AddTaskGroup(clients.Ctx, gomock.Any()).
+		Return(&created, nil).
+		Times(1)
+	extrasClient.
+		EXPECT().
+		PublishPreviewTaskGroup(clients.Ctx, taskagentextras.PublishPreviewTaskGroupArgs{
+			Project:     &testTaskGroupProjectID,
+			TaskGroupId: &testTaskGroupID,
+			TaskGroup: &taskagent.TaskGroupPublishPreviewParameter{
+				Preview:  converter.Bool(true),
+				Revision: created.Revision,
+				Version:  created.Version,
+			},
+		}).
+		Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, true)}, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, true)}, nil).
+		Times(1)
+
+	resourceData := getTaskGroupResourceData(t, true)
+	err := resourceTaskGroupCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testTaskGroupID.String(), resourceData.Id())
+}
+
+// verifies that changes to a task group in preview are published as a new version
+func TestTaskGroup_Update_PreviewPublishesNewVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	extrasClient := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: extrasClient,
+		Ctx:                   context.Background(),
+	}
+
+	resourceData := getTaskGroupResourceData(t, true)
+	resourceData.SetId(testTaskGroupID.String())
+
+	draftID := uuid.New()
+	gomock.InOrder(
+		taskAgentClient.
+			EXPECT().
+			GetTaskGroups(clients.Ctx, gomock.Any()).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, true)}, nil).
+			Times(1),
+		taskAgentClient.
+			EXPECT().
+			AddTaskGroup(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args taskagent.AddTaskGroupArgs) (*taskagent.TaskGroup, error) {
+				require.Equal(t, testTaskGroupID, *args.TaskGroup.ParentDefinitionId)
+				return &taskagent.TaskGroup{Id: &draftID, Revision: converter.Int(1)}, nil
+			}).
+			Times(1),
+		extrasClient.
+			EXPECT().
+			PublishTaskGroup(clients.Ctx, taskagentextras.PublishTaskGroupArgs{
+				Project:           &testTaskGroupProjectID,
+				ParentTaskGroupId: &testTaskGroupID,
+				TaskGroupPublishParameter: &taskagentextras.TaskGroupPublishParameter{
+					Preview:           converter.Bool(true),
+					TaskGroupId:       &draftID,
+					TaskGroupRevision: converter.Int(1),
+				},
+			}).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(2, true)}, nil).
+			Times(1),
+		taskAgentClient.
+			EXPECT().
+			GetTaskGroups(clients.Ctx, gomock.Any()).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, false), newTestTaskGroup(2, true)}, nil).
+			Times(1),
+	)
+
+	err := resourceTaskGroupUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, 2, resourceData.Get(tgVersion))
+}
+
+// verifies that a draft is published as a new version of the parent task group
+func TestTaskGroup_Create_PublishesDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	extrasClient := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: extrasClient,
+		Ctx:                   context.Background(),
+	}
+
+	resourceData := getTaskGroupResourceData(t, true)
+	resourceData.Set(tgParentTaskGroupID, testTaskGroupID.String())
+	resourceData.Set(tgPublish, true)
+
+	draftID := uuid.New()
+	gomock.InOrder(
+		taskAgentClient.
+			EXPECT().
+			AddTaskGroup(clients.Ctx, gomock.Any()).
+			DoAndReturn(func(_ context.Context, args taskagent.AddTaskGroupArgs) (*taskagent.TaskGroup, error) {
+				require.Equal(t, testTaskGroupID, *args.TaskGroup.ParentDefinitionId)
+				return &taskagent.TaskGroup{Id: &draftID, Revision: converter.Int(1)}, nil
+			}).
+			Times(1),
+		extrasClient.
+			EXPECT().
+			PublishTaskGroup(clients.Ctx, taskagentextras.PublishTaskGroupArgs{
+				Project:           &testTaskGroupProjectID,
+				ParentTaskGroupId: &testTaskGroupID,
+				TaskGroupPublishParameter: &taskagentextras.TaskGroupPublishParameter{
+					Preview:           converter.Bool(true),
+					TaskGroupId:       &draftID,
+					TaskGroupRevision: converter.Int(1),
+				},
+			}).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(2, true)}, nil).
+			Times(1),
+		taskAgentClient.
+			EXPECT().
+			GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+				Project:     &testTaskGroupProjectID,
+				TaskGroupId: &testTaskGroupID,
+			}).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, false), newTestTaskGroup(2, true)}, nil).
+			Times(1),
+	)
+
+	err := resourceTaskGroupCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, draftID.String(), resourceData.Id())
+	require.Equal(t, 2, resourceData.Get(tgVersion))
+	require.True(t, resourceData.Get(tgPreview).(bool))
+}
+
+// verifies that only changing preview of a published draft updates the preview state of the parent task group
+// instead of publishing another version
+func TestTaskGroup_Update_PublishedDraftPreviewOnly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	extrasClient := taskagentextras.NewMockClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient:       taskAgentClient,
+		TaskAgentClientExtras: extrasClient,
+		Ctx:                   context.Background(),
+	}
+
+	draftID := uuid.New()
+	prior := getTaskGroupResourceData(t, true)
+	prior.Set(tgParentTaskGroupID, testTaskGroupID.String())
+	prior.Set(tgPublish, true)
+	prior.SetId(draftID.String())
+
+	config := getTaskGroupConfig(false)
+	config[tgParentTaskGroupID] = testTaskGroupID.String()
+	config[tgPublish] = true
+
+	r := ResourceTaskGroup()
+	state := prior.State()
+	diff, err := r.Diff(clients.Ctx, state, terraform.NewResourceConfigRaw(config), clients)
+	require.Nil(t, err)
+
+	parent := newTestTaskGroup(2, true)
+	gomock.InOrder(
+		taskAgentClient.
+			EXPECT().
+			GetTaskGroups(clients.Ctx, gomock.Any()).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, false), parent}, nil).
+			Times(1),
+		extrasClient.
+			EXPECT().
+			PublishPreviewTaskGroup(clients.Ctx, taskagentextras.PublishPreviewTaskGroupArgs{
+				Project:     &testTaskGroupProjectID,
+				TaskGroupId: &testTaskGroupID,
+				TaskGroup: &taskagent.TaskGroupPublishPreviewParameter{
+					Preview:  converter.Bool(false),
+					Revision: parent.Revision,
+					Version:  parent.Version,
+				},
+			}).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(2, false)}, nil).
+			Times(1),
+		taskAgentClient.
+			EXPECT().
+			GetTaskGroups(clients.Ctx, gomock.Any()).
+			Return(&[]taskagent.TaskGroup{newTestTaskGroup(1, false), newTestTaskGroup(2, false)}, nil).
+			Times(1),
+	)
+	taskAgentClient.EXPECT().AddTaskGroup(gomock.Any(), gomock.Any()).Times(0)
+
+	updated, diags := r.Apply(clients.Ctx, state, diff, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, draftID.String(), updated.ID)
+	require.Equal(t, "false", updated.Attributes[tgPreview])
+}
+
+// verifies that a draft can only be published as preview if it is published
+func TestTaskGroup_Create_DraftPreviewRequiresPublish(t *testing.T) {
+	resourceData := getTaskGroupResourceData(t, true)
+	resourceData.Set(tgParentTaskGroupID, testTaskGroupID.String())
+
+	err := resourceTaskGroupCreate(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "set publish to publish the draft")
+}
@@ -1,9 +1,12 @@
 package taskagent
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log"
 	"net/url"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -35,6 +38,11 @@ const (
 	vgContentType       = "content_type"
 	vgEnabled           = "enabled"
 	vgExpires           = "expires"
+	vgSearchDepth       = "search_depth"
+	vgSyncAllSecrets    = "sync_all_secrets"
+	vgLinkedSecrets     = "linked_secrets"
+	vgKeyVaultSecrets   = "key_vault_secret_names"
+	vgIgnoreUnmanaged   = "ignore_unmanaged_variables"
 )
 
 const (
@@ -66,11 +74,12 @@ type KeyVaultSecretResult struct {
 // ResourceVariableGroup schema and implementation for variable group resource
 func ResourceVariableGroup() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceVariableGroupCreate,
-		Read:     resourceVariableGroupRead,
-		Update:   resourceVariableGroupUpdate,
-		Delete:   resourceVariableGroupDelete,
-		Importer: tfhelper.ImportProjectQualifiedResource(),
+		Create:        resourceVariableGroupCreate,
+		Read:          resourceVariableGroupRead,
+		Update:        resourceVariableGroupUpdate,
+		Delete:        resourceVariableGroupDelete,
+		CustomizeDiff: customizeVariableGroupDiff,
+		Importer:      tfhelper.ImportProjectQualifiedResource(),
 		Schema: map[string]*schema.Schema{
 			vgProjectID: {
 				Type:         schema.TypeString,
@@ -93,10 +102,15 @@ func ResourceVariableGroup() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			vgIgnoreUnmanaged: {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{vgKeyVault},
+			},
 			vgVariable: {
 				Type:     schema.TypeSet,
-				Required: true,
-				MinItems: 1,
+				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						vgName: {
@@ -153,14 +167,51 @@ func ResourceVariableGroup() *schema.Resource {
 							Required:     true,
 							ValidateFunc: validation.IsUUID,
 						},
-						"search_depth": {
+						vgSearchDepth: {
 							Type:     schema.TypeInt,
 							Optional: true,
 							Default:  20,
 						},
+						vgSyncAllSecrets: {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Default:       false,
+							ConflictsWith: []string{vgVariable},
+						},
+						vgLinkedSecrets: {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									vgName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									vgContentType: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									vgEnabled: {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									vgExpires: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
+			vgKeyVaultSecrets: {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -177,12 +228,16 @@ func resourceVariableGroupCreate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(" creating variable group in Azure DevOps: %+v", err)
 	}
 
-	err = flattenVariableGroup(d, addedVariableGroup, projectID)
+	err = flattenVariableGroup(d, filterUnmanagedVariables(d, addedVariableGroup), projectID)
 
 	if err != nil {
 		return fmt.Errorf(flatteningVariableGroupErrorMessageFormat, err)
 	}
 
+	if err = recordKeyVaultSecretNames(d, variableGroupParameters); err != nil {
+		return err
+	}
+
 	// Update Allow Access with definition Reference
 	definitionResourceReferenceArgs := expandAllowAccess(d, addedVariableGroup)
 	definitionResourceReference, err := updateDefinitionResourceAuth(clients, definitionResourceReferenceArgs, projectID)
@@ -222,12 +277,18 @@ func resourceVariableGroupRead(d *schema.ResourceData, m interface{}) error {
 		return nil
 	}
 
-	err = flattenVariableGroup(d, variableGroup, &projectID)
+	err = flattenVariableGroup(d, filterUnmanagedVariables(d, variableGroup), &projectID)
 
 	if err != nil {
 		return fmt.Errorf(flatteningVariableGroupErrorMessageFormat, err)
 	}
 
+	if isKeyVaultVariableGroupType(variableGroup.Type) {
+		if err = readKeyVaultSecretNames(d); err != nil {
+			return err
+		}
+	}
+
 	//Read the Authorization Resource for get allow access property
 	resourceRefType := "variablegroup"
 	varGroupID := strconv.Itoa(variableGroupID)
@@ -262,17 +323,28 @@ func resourceVariableGroupUpdate(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf(invalidVariableGroupIDErrorMessageFormat, err)
 	}
 
+	if d.Get(vgIgnoreUnmanaged).(bool) {
+		err = mergeUnmanagedVariables(clients, d, variableGroupParams, variableGroupID, projectID)
+		if err != nil {
+			return err
+		}
+	}
+
 	updatedVariableGroup, err := updateVariableGroup(clients, variableGroupParams, &variableGroupID, projectID)
 	if err != nil {
 		return fmt.Errorf("Error updating variable group in Azure DevOps: %+v", err)
 	}
 
-	err = flattenVariableGroup(d, updatedVariableGroup, projectID)
+	err = flattenVariableGroup(d, filterUnmanagedVariables(d, updatedVariableGroup), projectID)
 
 	if err != nil {
 		return fmt.Errorf(flatteningVariableGroupErrorMessageFormat, err)
 	}
 
+	if err = recordKeyVaultSecretNames(d, variableGroupParams); err != nil {
+		return err
+	}
+
 	// Update Allow Access
 	definitionResourceReferenceArgs := expandAllowAccess(d, updatedVariableGroup)
 	definitionResourceReference, err := updateDefinitionResourceAuth(clients, definitionResourceReferenceArgs, projectID)
@@ -341,6 +413,11 @@ func deleteVariableGroup(clients *client.AggregatedClient, projectId *string, va
 func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.ResourceData) (*v5taskagent.VariableGroupParameters, *string, error) {
 	projectID := converter.String(d.Get(vgProjectID).(string))
 	variables := d.Get(vgVariable).(*schema.Set).List()
+	keyVault := d.Get(vgKeyVault).([]interface{})
+
+	if len(keyVault) == 0 && len(variables) == 0 && !d.Get(vgIgnoreUnmanaged).(bool) {
+		return nil, nil, fmt.Errorf("At least one variable is required for a variable group that is not linked to an Azure Key Vault")
+	}
 
 	variableMap := make(map[string]interface{})
 
@@ -367,14 +444,13 @@ func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.R
 		Variables:   &variableMap,
 	}
 
-	keyVault := d.Get(vgKeyVault).([]interface{})
-
 	// Note: this will be of length 1 based on the schema definition above.
 	if len(keyVault) == 1 {
 		kvConfigures := keyVault[0].(map[string]interface{})
 		kvName := kvConfigures[vgName].(string)
 		serviceEndpointID := kvConfigures[vgServiceEndpointID].(string)
-		depth := kvConfigures["search_depth"].(int)
+		depth := kvConfigures[vgSearchDepth].(int)
+		syncAll := kvConfigures[vgSyncAllSecrets].(bool)
 
 		serviceEndpointUUID, err := uuid.Parse(serviceEndpointID)
 		if err != nil {
@@ -387,11 +463,19 @@ func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.R
 		}
 
 		variableGroup.Type = converter.String(azureKeyVaultType)
-		kvVariables, invalidVariables, err := searchAzureKVSecrets(clients, *projectID, kvName, serviceEndpointID, variables, depth)
+		if syncAll {
+			// all secrets of the vault are linked, the configured variables are not relevant
+			variables = nil
+		}
+		kvVariables, invalidVariables, err := searchAzureKVSecrets(clients, *projectID, kvName, serviceEndpointID, variables, depth, syncAll)
 		if err != nil {
 			return nil, nil, err
 		}
 
+		if syncAll && len(kvVariables) == 0 {
+			return nil, nil, fmt.Errorf("Azure Key Vault ( %s ) does not contain any secrets to link", kvName)
+		}
+
 		if len(invalidVariables) > 0 {
 			return nil, nil, fmt.Errorf("Invalid Key Vault secret: ( %s ) , can not find in Azure Key Vault: ( %s ) ",
 				strings.Join(invalidVariables, ","),
@@ -403,6 +487,63 @@ func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.R
 	return variableGroup, projectID, nil
 }
 
+// filterUnmanagedVariables returns a copy of the variable group which only contains the variables configured for
+// this resource, if unmanaged variables are ignored. Those variables are typically managed by
+// azuredevops_variable_group_variable resources or outside of Terraform.
+func filterUnmanagedVariables(d *schema.ResourceData, variableGroup *v5taskagent.VariableGroup) *v5taskagent.VariableGroup {
+	if !d.Get(vgIgnoreUnmanaged).(bool) || variableGroup.Variables == nil {
+		return variableGroup
+	}
+
+	managed := map[string]interface{}{}
+	for _, variable := range d.Get(vgVariable).(*schema.Set).List() {
+		name := variable.(map[string]interface{})[vgName].(string)
+		if existingName, ok := findVariableName(*variableGroup.Variables, name); ok {
+			managed[existingName] = (*variableGroup.Variables)[existingName]
+		}
+	}
+
+	filtered := *variableGroup
+	filtered.Variables = &managed
+	return &filtered
+}
+
+// mergeUnmanagedVariables adds the variables of the variable group which are not configured for this resource to
+// the update parameters, so that they are kept. Variables which were removed from the configuration are dropped.
+func mergeUnmanagedVariables(clients *client.AggregatedClient, d *schema.ResourceData, variableGroupParams *v5taskagent.VariableGroupParameters, variableGroupID int, projectID *string) error {
+	currentVariableGroup, err := clients.V5TaskAgentClient.GetVariableGroup(clients.Ctx, v5taskagent.GetVariableGroupArgs{
+		GroupId: &variableGroupID,
+		Project: projectID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error looking up variable group given ID (%v) and project ID (%v): %v", variableGroupID, *projectID, err)
+	}
+	if currentVariableGroup == nil || currentVariableGroup.Variables == nil {
+		return nil
+	}
+
+	oldVariables, _ := d.GetChange(vgVariable)
+	removed := map[string]bool{}
+	for _, variable := range oldVariables.(*schema.Set).List() {
+		removed[strings.ToLower(variable.(map[string]interface{})[vgName].(string))] = true
+	}
+
+	merged := map[string]interface{}{}
+	for name, variable := range *variableGroupParams.Variables {
+		merged[name] = variable
+		delete(removed, strings.ToLower(name))
+	}
+	for name, variable := range *currentVariableGroup.Variables {
+		if _, ok := findVariableName(merged, name); ok || removed[strings.ToLower(name)] {
+			continue
+		}
+		merged[name] = variable
+	}
+
+	variableGroupParams.Variables = &merged
+	return nil
+}
+
 // Convert AzDO data structure to internal Terraform data structure
 func flattenVariableGroup(d *schema.ResourceData, variableGroup *v5taskagent.VariableGroup, projectID *string) error {
 	d.SetId(fmt.Sprintf("%d", *variableGroup.Id))
@@ -416,6 +557,10 @@ func flattenVariableGroup(d *schema.ResourceData, variableGroup *v5taskagent.Var
 		return err
 	}
 
+	// the secrets linked by sync_all_secrets are exported by linked_secrets and not managed as variables
+	if isKeyVaultSyncAllSecrets(d) {
+		variables = []map[string]interface{}{}
+	}
 	if err = d.Set(vgVariable, variables); err != nil {
 		return err
 	}
@@ -434,6 +579,16 @@ func flattenVariableGroup(d *schema.ResourceData, variableGroup *v5taskagent.Var
 	return nil
 }
 
+func isKeyVaultSyncAllSecrets(d *schema.ResourceData) bool {
+	keyVault := d.Get(vgKeyVault).([]interface{})
+	if len(keyVault) != 1 || keyVault[0] == nil {
+		return false
+	}
+	// the key_vault block of the data source does not contain sync_all_secrets
+	syncAll, _ := keyVault[0].(map[string]interface{})[vgSyncAllSecrets].(bool)
+	return syncAll
+}
+
 func isKeyVaultVariableGroupType(variableGrouptype *string) bool {
 	return variableGrouptype != nil && *variableGrouptype == azureKeyVaultType
 }
@@ -525,37 +680,151 @@ func flattenKeyVault(d *schema.ResourceData, variableGroup *v5taskagent.Variable
 		return nil, fmt.Errorf("Unable to unmarshal provider data (%+v): %+v", providerData, err)
 	}
 
+	linkedSecrets, err := flattenKeyVaultLinkedSecrets(variableGroup)
+	if err != nil {
+		return nil, err
+	}
+
 	keyVault := []map[string]interface{}{{
 		vgName:              providerData.Vault,
 		vgServiceEndpointID: providerData.ServiceEndpointId.String(),
+		vgLinkedSecrets:     linkedSecrets,
 	}}
 
-	keyVaultRaw := d.Get("key_vault").([]interface{})
-	if len(keyVaultRaw) == 1 {
+	keyVaultRaw := d.Get(vgKeyVault).([]interface{})
+	if len(keyVaultRaw) == 1 && keyVaultRaw[0] != nil {
 		kvConfigures := keyVaultRaw[0].(map[string]interface{})
-		keyVault[0]["search_depth"] = kvConfigures["search_depth"].(int)
+		if depth, ok := kvConfigures[vgSearchDepth]; ok {
+			keyVault[0][vgSearchDepth] = depth.(int)
+		}
+		if syncAll, ok := kvConfigures[vgSyncAllSecrets]; ok {
+			keyVault[0][vgSyncAllSecrets] = syncAll.(bool)
+		}
 	}
 
 	return keyVault, nil
 }
 
-// Convert internal Terraform data structure to an AzDO data structure for Allow Access
-func expandAllowAccess(d *schema.ResourceData, createdVariableGroup *v5taskagent.VariableGroup) []build.DefinitionResourceReference {
-	resourceRefType := "variablegroup"
-	variableGroupID := strconv.Itoa(*createdVariableGroup.Id)
+// flattenKeyVaultLinkedSecrets lists the secrets which are actually linked to the variable group, sorted by name
+func flattenKeyVaultLinkedSecrets(variableGroup *v5taskagent.VariableGroup) ([]interface{}, error) {
+	linkedSecrets := make([]interface{}, 0)
+	if variableGroup.Variables == nil {
+		return linkedSecrets, nil
+	}
 
-	var arrayDefinitionResourceReference []build.DefinitionResourceReference
+	names := make([]string, 0, len(*variableGroup.Variables))
+	for name := range *variableGroup.Variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
 
-	defResourceRef := build.DefinitionResourceReference{
-		Type:       &resourceRefType,
-		Authorized: converter.Bool(d.Get(vgAllowAccess).(bool)),
-		Name:       createdVariableGroup.Name,
-		Id:         &variableGroupID,
+	for _, name := range names {
+		variableAsJSON, err := json.Marshal((*variableGroup.Variables)[name])
+		if err != nil {
+			return nil, fmt.Errorf("Unable to marshal variable into JSON: %+v", err)
+		}
+		secret, err := flattenKeyVaultVariable(variableAsJSON, name)
+		if err != nil {
+			return nil, err
+		}
+		linkedSecrets = append(linkedSecrets, map[string]interface{}{
+			vgName:        name,
+			vgContentType: secret[vgContentType],
+			vgEnabled:     secret[vgEnabled],
+			vgExpires:     secret[vgExpires],
+		})
+	}
+	return linkedSecrets, nil
+}
+
+// recordKeyVaultSecretNames records the names of the Azure Key Vault secrets which were linked to a variable group
+// that syncs all secrets of the vault, so that secrets added to or removed from the vault can be detected on plan.
+func recordKeyVaultSecretNames(d *schema.ResourceData, variableGroupParameters *v5taskagent.VariableGroupParameters) error {
+	if !isKeyVaultSyncAllSecrets(d) || variableGroupParameters.Variables == nil {
+		return d.Set(vgKeyVaultSecrets, []interface{}{})
 	}
 
-	arrayDefinitionResourceReference = append(arrayDefinitionResourceReference, defResourceRef)
+	names := make([]interface{}, 0, len(*variableGroupParameters.Variables))
+	for name := range *variableGroupParameters.Variables {
+		names = append(names, name)
+	}
+	return d.Set(vgKeyVaultSecrets, names)
+}
 
-	return arrayDefinitionResourceReference
+// readKeyVaultSecretNames keeps the recorded names of the linked Azure Key Vault secrets. Without recorded names,
+// e.g. after an import, the names of the secrets linked to the variable group are recorded.
+func readKeyVaultSecretNames(d *schema.ResourceData) error {
+	if !isKeyVaultSyncAllSecrets(d) {
+		return d.Set(vgKeyVaultSecrets, []interface{}{})
+	}
+	if d.Get(vgKeyVaultSecrets).(*schema.Set).Len() > 0 {
+		return nil
+	}
+
+	names := []interface{}{}
+	for _, secret := range d.Get(vgKeyVault + ".0." + vgLinkedSecrets).([]interface{}) {
+		names = append(names, secret.(map[string]interface{})[vgName].(string))
+	}
+	return d.Set(vgKeyVaultSecrets, names)
+}
+
+// customizeVariableGroupDiff plans an update of a variable group which syncs all secrets of an Azure Key Vault,
+// if secrets were added to or removed from the vault since they were linked. Only the names of the secrets are
+// compared, and the vault is only looked up if all of its secrets are synced.
+func customizeVariableGroupDiff(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+	clients, ok := m.(*client.AggregatedClient)
+	if d.Id() == "" || !ok || clients == nil {
+		return nil
+	}
+	keyVault := d.Get(vgKeyVault).([]interface{})
+	if len(keyVault) != 1 || keyVault[0] == nil {
+		return nil
+	}
+	kvConfigures := keyVault[0].(map[string]interface{})
+	if !kvConfigures[vgSyncAllSecrets].(bool) {
+		return nil
+	}
+
+	// a change of the vault or of sync_all_secrets already plans an update which links the current secrets
+	recordedSecrets := d.Get(vgKeyVaultSecrets).(*schema.Set)
+	if recordedSecrets.Len() == 0 || d.HasChange(vgKeyVault+".0."+vgName) || d.HasChange(vgKeyVault+".0."+vgSyncAllSecrets) {
+		return nil
+	}
+
+	kvName := kvConfigures[vgName].(string)
+	vaultSecrets, _, err := searchAzureKVSecrets(clients, d.Get(vgProjectID).(string), kvName, kvConfigures[vgServiceEndpointID].(string), nil, kvConfigures[vgSearchDepth].(int), true)
+	if err != nil {
+		// the linked secrets are kept until the vault can be read again, so only report the problem
+		log.Printf("[WARN] Unable to check variable group %s for Azure Key Vault drift: %+v", d.Id(), err)
+		return nil
+	}
+
+	// the set is built with the hash function of the recorded set, otherwise equal names do not compare as equal
+	currentSecrets := schema.NewSet(recordedSecrets.F, nil)
+	for name := range vaultSecrets {
+		currentSecrets.Add(name)
+	}
+	if currentSecrets.Equal(recordedSecrets) {
+		return nil
+	}
+	log.Printf("[INFO] Secrets of Azure Key Vault %s have been added or removed since they were linked to variable group %s", kvName, d.Id())
+	return d.SetNewComputed(vgKeyVaultSecrets)
+}
+
+// Convert internal Terraform data structure to an AzDO data structure for Allow Access
+func expandAllowAccess(d *schema.ResourceData, createdVariableGroup *v5taskagent.VariableGroup) []build.DefinitionResourceReference {
+	variableGroupID := strconv.Itoa(*createdVariableGroup.Id)
+	return expandDefinitionResourceReferences("variablegroup", variableGroupID, converter.ToString(createdVariableGroup.Name, ""), d.Get(vgAllowAccess).(bool))
+}
+
+// expandDefinitionResourceReferences returns the reference to authorize or unauthorize a resource of the type for all pipelines
+func expandDefinitionResourceReferences(resourceType string, resourceID string, name string, authorized bool) []build.DefinitionResourceReference {
+	return []build.DefinitionResourceReference{{
+		Type:       converter.String(resourceType),
+		Authorized: converter.Bool(authorized),
+		Name:       converter.String(name),
+		Id:         converter.String(resourceID),
+	}}
 }
 
 // Make the Azure DevOps API call to update the Definition resource = Allow Access
@@ -609,7 +878,9 @@ func flattenAllowAccess(d *schema.ResourceData, definitionResource *[]build.Defi
 	d.Set(vgAllowAccess, allowAccess)
 }
 
-func searchAzureKVSecrets(clients *client.AggregatedClient, projectID, kvName, serviceEndpointID string, variables []interface{}, depth int) (kvSecrets map[string]interface{}, invalidSecrets []string, error error) {
+// searchAzureKVSecrets looks up the secrets of an Azure Key Vault through the service endpoint proxy. Only the
+// secrets named in variables are returned, unless syncAll is set in which case every secret in the vault is returned.
+func searchAzureKVSecrets(clients *client.AggregatedClient, projectID, kvName, serviceEndpointID string, variables []interface{}, depth int, syncAll bool) (kvSecrets map[string]interface{}, invalidSecrets []string, error error) {
 	var token, loop, azkvSecretsRaw = "", 0, &KeyVaultSecretResult{}
 	kvSecrets = make(map[string]interface{})
 	invalidSecrets = make([]string, 0)
@@ -624,7 +895,7 @@ func searchAzureKVSecrets(clients *client.AggregatedClient, projectID, kvName, s
 		if azKVSecrets, err := getKVSecretServiceEndpointProxy(clients, kvName, projectID, serviceEndpointID, token); err == nil {
 			azkvSecretsRaw, token, err = parseKVSecretResp(azKVSecrets)
 			if err != nil {
-				return nil, nil, err
+				return nil, nil, keyVaultSecretListError(kvName, serviceEndpointID, err)
 			}
 			for _, secret := range *azkvSecretsRaw.Value {
 				name := getSecretName(*secret.ID)
@@ -642,6 +913,16 @@ func searchAzureKVSecrets(clients *client.AggregatedClient, projectID, kvName, s
 				kvSecretsMap[name] = kvVariable
 			}
 
+			if syncAll {
+				for name, secret := range kvSecretsMap {
+					kvSecrets[name] = secret
+				}
+				if token == "" {
+					return kvSecrets, invalidSecrets, nil
+				}
+				continue
+			}
+
 			// search secret
 			for name, secret := range kvSecretsMap {
 				if len(secretNames) == 0 {
@@ -662,13 +943,16 @@ func searchAzureKVSecrets(clients *client.AggregatedClient, projectID, kvName, s
 			}
 			loop++
 		} else {
-			return nil, nil, fmt.Errorf("Failed to get the Azure Key vault secrets. %v ", err)
+			return nil, nil, keyVaultSecretListError(kvName, serviceEndpointID, err)
 		}
 	}
 }
 
 func parseKVSecretResp(azKVSecrets *serviceendpoint.ServiceEndpointRequestResult) (*KeyVaultSecretResult, string, error) {
-	if azKVSecrets != nil && *azKVSecrets.StatusCode == "ok" {
+	if azKVSecrets == nil || azKVSecrets.StatusCode == nil {
+		return nil, "", fmt.Errorf("the service endpoint returned no response")
+	}
+	if strings.EqualFold(*azKVSecrets.StatusCode, "ok") {
 		var kvSecrets KeyVaultSecretResult
 		secretJson := azKVSecrets.Result.([]interface{})[0].(string)
 		if err := json.Unmarshal([]byte(secretJson), &kvSecrets); err != nil {
@@ -681,7 +965,34 @@ func parseKVSecretResp(azKVSecrets *serviceendpoint.ServiceEndpointRequestResult
 		}
 		return &kvSecrets, token, nil
 	}
-	return nil, "", fmt.Errorf("Failed to get the Azure Key valut.  Erroe: ( code: %s, messge: %s )", *azKVSecrets.StatusCode, *azKVSecrets.ErrorMessage)
+	return nil, "", &keyVaultRequestError{
+		StatusCode: *azKVSecrets.StatusCode,
+		Message:    converter.ToString(azKVSecrets.ErrorMessage, ""),
+	}
+}
+
+// keyVaultRequestError is returned when the service endpoint proxy reports an unsuccessful request to the Azure Key Vault
+type keyVaultRequestError struct {
+	StatusCode string
+	Message    string
+}
+
+func (e *keyVaultRequestError) Error() string {
+	return fmt.Sprintf("code: %s, message: %s", e.StatusCode, e.Message)
+}
+
+// keyVaultSecretListError explains why the secrets of an Azure Key Vault cannot be listed through a service endpoint
+func keyVaultSecretListError(kvName string, serviceEndpointID string, err error) error {
+	hint := ""
+	if requestErr, ok := err.(*keyVaultRequestError); ok {
+		switch strings.ToLower(requestErr.StatusCode) {
+		case "unauthorized", "forbidden":
+			hint = " Make sure the service principal of the service endpoint has the Get and List secret permissions on the Key Vault."
+		case "notfound":
+			hint = " Make sure the Key Vault exists in the subscription of the service endpoint."
+		}
+	}
+	return fmt.Errorf("Service endpoint ( %s ) cannot list the secrets of Azure Key Vault ( %s ): %v.%s", serviceEndpointID, kvName, err, hint)
 }
 
 func getKVSecretServiceEndpointProxy(clients *client.AggregatedClient, kvName string, projectID string, serviceEndpointID string, token string) (*serviceendpoint.ServiceEndpointRequestResult, error) {
@@ -4,6 +4,22 @@
 
 package taskagent
 
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	v5taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
 // The tests in this file use the mock clients in mock_client.go to mock out
 // the Azure DevOps client operations.
 
@@ -147,3 +163,198 @@ package taskagent
 //	providerDataActual, _ := json.Marshal(variableGroupParams.ProviderData)
 //	require.Equal(t, providerDataExpected, providerDataActual)
 //}
+
+// verifies that all secrets of the vault are returned, across pages, when syncing all secrets
+func TestVariableGroupKeyVault_SearchSecrets_SyncAll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	firstPage := &serviceendpoint.ServiceEndpointRequestResult{
+		Result: []interface{}{
+			`{"value": [{"contentType": "text/plain", "id": "https://mock.vault.azure.net/secrets/var1", "attributes": {"enabled": true, "exp": 1675424159}}], "nextLink": "https://mock.vault.azure.net/secrets?$skiptoken=next"}`,
+		},
+		StatusCode: converter.String("ok"),
+	}
+	secondPage := &serviceendpoint.ServiceEndpointRequestResult{
+		Result: []interface{}{
+			`{"value": [{"id": "https://mock.vault.azure.net/secrets/var2", "attributes": {"enabled": false}}], "nextLink": null}`,
+		},
+		StatusCode: converter.String("ok"),
+	}
+	gomock.InOrder(
+		serviceEndpointClient.EXPECT().ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).Return(firstPage, nil),
+		serviceEndpointClient.EXPECT().ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).Return(secondPage, nil),
+	)
+
+	secrets, invalid, err := searchAzureKVSecrets(clients, "project", "mock", "endpoint", nil, 1, true)
+	require.Nil(t, err)
+	require.Empty(t, invalid)
+	require.Len(t, secrets, 2)
+	require.Contains(t, secrets, "var1")
+	require.Contains(t, secrets, "var2")
+}
+
+// verifies that a service endpoint without access to the vault results in an actionable error
+func TestVariableGroupKeyVault_SearchSecrets_ReportsMissingPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+		Return(&serviceendpoint.ServiceEndpointRequestResult{
+			StatusCode:   converter.String("forbidden"),
+			ErrorMessage: converter.String("The user does not have secrets list permission"),
+		}, nil).
+		Times(1)
+
+	_, _, err := searchAzureKVSecrets(clients, "project", "mock", "endpoint", nil, 20, true)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Service endpoint ( endpoint ) cannot list the secrets of Azure Key Vault ( mock )")
+	require.Contains(t, err.Error(), "The user does not have secrets list permission")
+	require.Contains(t, err.Error(), "Get and List secret permissions")
+}
+
+// verifies that the linked secrets are flattened in name order with their attributes
+func TestVariableGroupKeyVault_FlattenLinkedSecrets(t *testing.T) {
+	variableGroup := &v5taskagent.VariableGroup{
+		Type: converter.String(azureKeyVaultType),
+		Variables: &map[string]interface{}{
+			"var2": v5taskagent.AzureKeyVaultVariableValue{
+				IsSecret: converter.Bool(true),
+				Enabled:  converter.Bool(false),
+			},
+			"var1": v5taskagent.AzureKeyVaultVariableValue{
+				IsSecret:    converter.Bool(true),
+				Enabled:     converter.Bool(true),
+				ContentType: converter.String("text/plain"),
+			},
+		},
+	}
+
+	linkedSecrets, err := flattenKeyVaultLinkedSecrets(variableGroup)
+	require.Nil(t, err)
+	require.Len(t, linkedSecrets, 2)
+
+	first := linkedSecrets[0].(map[string]interface{})
+	require.Equal(t, "var1", first[vgName])
+	require.Equal(t, true, first[vgEnabled])
+	require.Equal(t, "text/plain", first[vgContentType])
+
+	second := linkedSecrets[1].(map[string]interface{})
+	require.Equal(t, "var2", second[vgName])
+	require.Equal(t, false, second[vgEnabled])
+}
+
+// verifies that a variable group syncing all secrets does not manage the linked secrets as variables and
+// records the names of the linked secrets, so that secrets added to the vault are detected
+func TestVariableGroupKeyVault_SyncAll_RecordsLinkedSecretNames(t *testing.T) {
+	serviceEndpointID := uuid.New()
+	d := schema.TestResourceDataRaw(t, ResourceVariableGroup().Schema, map[string]interface{}{
+		vgProjectID: "project",
+		vgName:      "name",
+		vgKeyVault: []interface{}{map[string]interface{}{
+			vgName:              "mock",
+			vgServiceEndpointID: serviceEndpointID.String(),
+			vgSyncAllSecrets:    true,
+		}},
+	})
+
+	variableGroup := &v5taskagent.VariableGroup{
+		Id:   converter.Int(1),
+		Name: converter.String("name"),
+		Type: converter.String(azureKeyVaultType),
+		ProviderData: v5taskagent.AzureKeyVaultVariableGroupProviderData{
+			ServiceEndpointId: &serviceEndpointID,
+			Vault:             converter.String("mock"),
+		},
+		Variables: &map[string]interface{}{
+			"var1": v5taskagent.AzureKeyVaultVariableValue{IsSecret: converter.Bool(true)},
+		},
+	}
+	require.Nil(t, flattenVariableGroup(d, variableGroup, converter.String("project")))
+	require.Equal(t, 0, d.Get(vgVariable).(*schema.Set).Len())
+	require.Equal(t, "var1", d.Get(vgKeyVault+".0."+vgLinkedSecrets+".0."+vgName))
+	require.True(t, d.Get(vgKeyVault+".0."+vgSyncAllSecrets).(bool))
+
+	// without recorded names, e.g. after an import, the names of the linked secrets are recorded
+	require.Nil(t, readKeyVaultSecretNames(d))
+	require.Equal(t, []interface{}{"var1"}, d.Get(vgKeyVaultSecrets).(*schema.Set).List())
+
+	require.Nil(t, recordKeyVaultSecretNames(d, &v5taskagent.VariableGroupParameters{
+		Variables: &map[string]interface{}{
+			"var1": v5taskagent.AzureKeyVaultVariableValue{},
+			"var2": v5taskagent.AzureKeyVaultVariableValue{},
+		},
+	}))
+	require.Nil(t, readKeyVaultSecretNames(d))
+	vaultSecrets := d.Get(vgKeyVaultSecrets).(*schema.Set)
+	require.Equal(t, 2, vaultSecrets.Len())
+	require.True(t, vaultSecrets.Contains("var2"))
+}
+
+// verifies that an update is only planned if the names of the secrets in the vault differ from the recorded names
+func TestVariableGroupKeyVault_SyncAll_PlansUpdateIfSecretNamesChanged(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	config := map[string]interface{}{
+		vgProjectID: "project",
+		vgName:      "name",
+		vgKeyVault: []interface{}{map[string]interface{}{
+			vgName:              "mock",
+			vgServiceEndpointID: uuid.New().String(),
+			vgSyncAllSecrets:    true,
+		}},
+	}
+	r := ResourceVariableGroup()
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	d.SetId("1")
+	require.Nil(t, d.Set(vgKeyVaultSecrets, []interface{}{"var1"}))
+	state := d.State()
+
+	vaultResponse := func(secretIDs string) *serviceendpoint.ServiceEndpointRequestResult {
+		return &serviceendpoint.ServiceEndpointRequestResult{
+			Result:     []interface{}{`{"value": [` + secretIDs + `], "nextLink": null}`},
+			StatusCode: converter.String("ok"),
+		}
+	}
+	gomock.InOrder(
+		serviceEndpointClient.
+			EXPECT().
+			ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+			Return(vaultResponse(`{"id": "https://mock.vault.azure.net/secrets/var1"}`), nil).
+			Times(1),
+		serviceEndpointClient.
+			EXPECT().
+			ExecuteServiceEndpointRequest(clients.Ctx, gomock.Any()).
+			Return(vaultResponse(`{"id": "https://mock.vault.azure.net/secrets/var1"}, {"id": "https://mock.vault.azure.net/secrets/var2"}`), nil).
+			Times(1),
+	)
+
+	diff, err := r.Diff(clients.Ctx, state, terraform.NewResourceConfigRaw(config), clients)
+	require.Nil(t, err)
+	require.True(t, diff == nil || diff.Attributes[vgKeyVaultSecrets+".#"] == nil)
+
+	diff, err = r.Diff(clients.Ctx, state, terraform.NewResourceConfigRaw(config), clients)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+	require.True(t, diff.Attributes[vgKeyVaultSecrets+".#"].NewComputed)
+
+	// the vault is not looked up if not all of its secrets are synced
+	config[vgKeyVault].([]interface{})[0].(map[string]interface{})[vgSyncAllSecrets] = false
+	config[vgVariable] = []interface{}{map[string]interface{}{vgName: "var1"}}
+	d = schema.TestResourceDataRaw(t, r.Schema, config)
+	d.SetId("1")
+	_, err = r.Diff(clients.Ctx, d.State(), terraform.NewResourceConfigRaw(config), clients)
+	require.Nil(t, err)
+}
@@ -0,0 +1,353 @@
+package taskagent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v5taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+const vgVariableGroupID = "variable_group_id"
+
+// variableGroupLocks serializes the read-modify-write cycles of variables in the same variable group,
+// as all variables of a group are written with a single update
+var variableGroupLocks sync.Map
+
+// ResourceVariableGroupVariable schema and implementation for a single variable of a variable group
+func ResourceVariableGroupVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVariableGroupVariableCreate,
+		Read:   resourceVariableGroupVariableRead,
+		Update: resourceVariableGroupVariableUpdate,
+		Delete: resourceVariableGroupVariableDelete,
+		Importer: &schema.ResourceImporter{
+			State: importVariableGroupVariable,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(2 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			vgProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			vgVariableGroupID: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			vgName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			vgValue: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{secretVgValue},
+			},
+			secretVgValue: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Default:       "",
+				ConflictsWith: []string{vgValue},
+			},
+			vgIsSecret: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceVariableGroupVariableCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get(vgProjectID).(string)
+	variableGroupID := d.Get(vgVariableGroupID).(int)
+	name := d.Get(vgName).(string)
+
+	value := expandVariableGroupVariableValue(d)
+	err := modifyVariableGroupVariables(clients, projectID, variableGroupID, d.Timeout(schema.TimeoutCreate), func(variables map[string]interface{}) error {
+		if existingName, ok := findVariableName(variables, name); ok {
+			return fmt.Errorf("Variable %s already exists in variable group %d. Import it to manage it with Terraform", existingName, variableGroupID)
+		}
+		variables[name] = value
+		return nil
+	}, func(variables map[string]interface{}) bool {
+		return hasVariableValue(variables, name, value)
+	})
+	if err != nil {
+		return fmt.Errorf(" creating variable %s in variable group %d: %+v", name, variableGroupID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d/%s", projectID, variableGroupID, name))
+	return resourceVariableGroupVariableRead(d, m)
+}
+
+func resourceVariableGroupVariableRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID, variableGroupID, name, err := parseVariableGroupVariableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	variableGroup, err := clients.V5TaskAgentClient.GetVariableGroup(clients.Ctx, v5taskagent.GetVariableGroupArgs{
+		Project: &projectID,
+		GroupId: &variableGroupID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error looking up variable group given ID (%v) and project ID (%v): %v", variableGroupID, projectID, err)
+	}
+	if variableGroup == nil || variableGroup.Id == nil || variableGroup.Variables == nil {
+		d.SetId("")
+		return nil
+	}
+
+	variableName, ok := findVariableName(*variableGroup.Variables, name)
+	if !ok {
+		d.SetId("")
+		return nil
+	}
+
+	variable, err := toVariableValue((*variableGroup.Variables)[variableName])
+	if err != nil {
+		return err
+	}
+
+	d.Set(vgProjectID, projectID)
+	d.Set(vgVariableGroupID, variableGroupID)
+	d.Set(vgName, variableName)
+
+	isSecret := converter.ToBool(variable.IsSecret, false)
+	d.Set(vgIsSecret, isSecret)
+	// The AzDO API does not return the value of secret variables, so the secret value is kept from the state
+	if !isSecret {
+		d.Set(vgValue, converter.ToString(variable.Value, ""))
+		d.Set(secretVgValue, "")
+	} else {
+		d.Set(vgValue, "")
+	}
+	return nil
+}
+
+func resourceVariableGroupVariableUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID, variableGroupID, name, err := parseVariableGroupVariableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	value := expandVariableGroupVariableValue(d)
+	err = modifyVariableGroupVariables(clients, projectID, variableGroupID, d.Timeout(schema.TimeoutUpdate), func(variables map[string]interface{}) error {
+		existingName, ok := findVariableName(variables, name)
+		if !ok {
+			return fmt.Errorf("Variable %s no longer exists in variable group %d", name, variableGroupID)
+		}
+		variables[existingName] = value
+		return nil
+	}, func(variables map[string]interface{}) bool {
+		return hasVariableValue(variables, name, value)
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating variable %s in variable group %d: %+v", name, variableGroupID, err)
+	}
+
+	return resourceVariableGroupVariableRead(d, m)
+}
+
+func resourceVariableGroupVariableDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID, variableGroupID, name, err := parseVariableGroupVariableID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = modifyVariableGroupVariables(clients, projectID, variableGroupID, d.Timeout(schema.TimeoutDelete), func(variables map[string]interface{}) error {
+		if existingName, ok := findVariableName(variables, name); ok {
+			delete(variables, existingName)
+		}
+		return nil
+	}, func(variables map[string]interface{}) bool {
+		_, ok := findVariableName(variables, name)
+		return !ok
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting variable %s from variable group %d: %+v", name, variableGroupID, err)
+	}
+	return nil
+}
+
+func importVariableGroupVariable(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, variableGroupID, name, err := parseVariableGroupVariableID(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing the variable group variable ID: %v. Expected format <projectID>/<variableGroupID>/<variableName>", err)
+	}
+	d.Set(vgProjectID, projectID)
+	d.Set(vgVariableGroupID, variableGroupID)
+	d.Set(vgName, name)
+	return []*schema.ResourceData{d}, nil
+}
+
+// modifyVariableGroupVariables applies modify to the variables of a variable group and writes the whole group back.
+// Variable groups do not support optimistic concurrency, so the group is re-read after it has been written and the
+// cycle is retried if another client overwrote the change in the meantime. isApplied reports whether the variables
+// contain the change, so that a retry does not apply a change again which was already written.
+func modifyVariableGroupVariables(clients *client.AggregatedClient, projectID string, variableGroupID int, timeout time.Duration, modify func(variables map[string]interface{}) error, isApplied func(variables map[string]interface{}) bool) error {
+	lock, _ := variableGroupLocks.LoadOrStore(fmt.Sprintf("%s/%d", projectID, variableGroupID), &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	retry := false
+	return resource.Retry(timeout, func() *resource.RetryError { //nolint:staticcheck
+		variableGroup, err := clients.V5TaskAgentClient.GetVariableGroup(clients.Ctx, v5taskagent.GetVariableGroupArgs{
+			Project: &projectID,
+			GroupId: &variableGroupID,
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if variableGroup == nil || variableGroup.Id == nil {
+			return resource.NonRetryableError(fmt.Errorf("Variable group %d does not exist in project %s", variableGroupID, projectID))
+		}
+		if isKeyVaultVariableGroupType(variableGroup.Type) {
+			return resource.NonRetryableError(fmt.Errorf("Variables of variable group %d are linked from an Azure Key Vault and cannot be managed individually", variableGroupID))
+		}
+
+		variables := map[string]interface{}{}
+		if variableGroup.Variables != nil {
+			for k, v := range *variableGroup.Variables {
+				variables[k] = v
+			}
+		}
+		if retry && isApplied(variables) {
+			return nil
+		}
+		retry = true
+
+		if err := modify(variables); err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		_, err = clients.V5TaskAgentClient.UpdateVariableGroup(clients.Ctx, v5taskagent.UpdateVariableGroupArgs{
+			Project: &projectID,
+			GroupId: &variableGroupID,
+			Group: &v5taskagent.VariableGroupParameters{
+				Name:         variableGroup.Name,
+				Description:  variableGroup.Description,
+				Type:         variableGroup.Type,
+				ProviderData: variableGroup.ProviderData,
+				Variables:    &variables,
+			},
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		written, err := clients.V5TaskAgentClient.GetVariableGroup(clients.Ctx, v5taskagent.GetVariableGroupArgs{
+			Project: &projectID,
+			GroupId: &variableGroupID,
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if written == nil || written.Variables == nil || !isApplied(*written.Variables) {
+			return resource.RetryableError(fmt.Errorf("Variable group %d has been updated concurrently by another client", variableGroupID))
+		}
+		return nil
+	})
+}
+
+// hasVariableValue reports whether the variable has the value. The AzDO API does not return the value of
+// secret variables, so only the secret flag of those can be compared.
+func hasVariableValue(variables map[string]interface{}, name string, value v5taskagent.VariableValue) bool {
+	existingName, ok := findVariableName(variables, name)
+	if !ok {
+		return false
+	}
+	existing, err := toVariableValue(variables[existingName])
+	if err != nil {
+		return false
+	}
+	if converter.ToBool(existing.IsSecret, false) != converter.ToBool(value.IsSecret, false) {
+		return false
+	}
+	return converter.ToBool(value.IsSecret, false) || converter.ToString(existing.Value, "") == converter.ToString(value.Value, "")
+}
+
+// findVariableName finds a variable by name. Variable names are case insensitive in Azure DevOps.
+func findVariableName(variables map[string]interface{}, name string) (string, bool) {
+	if _, ok := variables[name]; ok {
+		return name, true
+	}
+	for k := range variables {
+		if strings.EqualFold(k, name) {
+			return k, true
+		}
+	}
+	return "", false
+}
+
+func expandVariableGroupVariableValue(d *schema.ResourceData) v5taskagent.VariableValue {
+	isSecret := d.Get(vgIsSecret).(bool)
+	if isSecret {
+		return v5taskagent.VariableValue{
+			Value:    converter.String(d.Get(secretVgValue).(string)),
+			IsSecret: converter.Bool(true),
+		}
+	}
+	return v5taskagent.VariableValue{
+		Value:    converter.String(d.Get(vgValue).(string)),
+		IsSecret: converter.Bool(false),
+	}
+}
+
+func toVariableValue(variable interface{}) (*v5taskagent.VariableValue, error) {
+	variableAsJSON, err := json.Marshal(variable)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal variable into JSON: %+v", err)
+	}
+	var value v5taskagent.VariableValue
+	if err := json.Unmarshal(variableAsJSON, &value); err != nil {
+		return nil, fmt.Errorf("Unable to unmarshal variable (%+v): %+v", variable, err)
+	}
+	return &value, nil
+}
+
+func parseVariableGroupVariableID(id string) (string, int, string, error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+		return "", 0, "", fmt.Errorf("unexpected format of ID (%s), expected projectID/variableGroupID/variableName", id)
+	}
+	variableGroupID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return "", 0, "", fmt.Errorf("Variable group ID (%s) is not a valid integer: %v", parts[1], err)
+	}
+	return parts[0], variableGroupID, parts[2], nil
+}
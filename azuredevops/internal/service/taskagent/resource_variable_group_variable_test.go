@@ -0,0 +1,98 @@
+//go:build (all || resource_variable_group_variable) && !exclude_resource_variable_group_variable
+// +build all resource_variable_group_variable
+// +build !exclude_resource_variable_group_variable
+
+package taskagent
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v5taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the ID of a variable group variable can be parsed
+func TestVariableGroupVariable_ParseID(t *testing.T) {
+	projectID, variableGroupID, name, err := parseVariableGroupVariableID("00000000-0000-0000-0000-000000000001/10/var.with/slash")
+	require.Nil(t, err)
+	require.Equal(t, "00000000-0000-0000-0000-000000000001", projectID)
+	require.Equal(t, 10, variableGroupID)
+	require.Equal(t, "var.with/slash", name)
+
+	_, _, _, err = parseVariableGroupVariableID("project/10")
+	require.NotNil(t, err)
+
+	_, _, _, err = parseVariableGroupVariableID("project/abc/var")
+	require.NotNil(t, err)
+}
+
+// verifies that variables are looked up case insensitive
+func TestVariableGroupVariable_FindVariableName(t *testing.T) {
+	variables := map[string]interface{}{
+		"Var1": nil,
+	}
+
+	name, ok := findVariableName(variables, "var1")
+	require.True(t, ok)
+	require.Equal(t, "Var1", name)
+
+	_, ok = findVariableName(variables, "var2")
+	require.False(t, ok)
+}
+
+// verifies that the value of secret variables is sent as secret value
+func TestVariableGroupVariable_ExpandValue(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceVariableGroupVariable().Schema, nil)
+	resourceData.Set(vgValue, "plain")
+	value := expandVariableGroupVariableValue(resourceData)
+	require.Equal(t, "plain", *value.Value)
+	require.False(t, *value.IsSecret)
+
+	resourceData = schema.TestResourceDataRaw(t, ResourceVariableGroupVariable().Schema, nil)
+	resourceData.Set(secretVgValue, "secret")
+	resourceData.Set(vgIsSecret, true)
+	value = expandVariableGroupVariableValue(resourceData)
+	require.Equal(t, "secret", *value.Value)
+	require.True(t, *value.IsSecret)
+}
+
+// verifies that a written variable is recognized, so that a retry does not create or update it again
+func TestVariableGroupVariable_HasVariableValue(t *testing.T) {
+	variables := map[string]interface{}{
+		"var1":   v5taskagent.VariableValue{Value: converter.String("value"), IsSecret: converter.Bool(false)},
+		"Secret": v5taskagent.VariableValue{IsSecret: converter.Bool(true)},
+	}
+
+	require.True(t, hasVariableValue(variables, "VAR1", v5taskagent.VariableValue{Value: converter.String("value"), IsSecret: converter.Bool(false)}))
+	require.False(t, hasVariableValue(variables, "var1", v5taskagent.VariableValue{Value: converter.String("other"), IsSecret: converter.Bool(false)}))
+	require.False(t, hasVariableValue(variables, "var1", v5taskagent.VariableValue{Value: converter.String("value"), IsSecret: converter.Bool(true)}))
+	require.True(t, hasVariableValue(variables, "secret", v5taskagent.VariableValue{Value: converter.String("hidden"), IsSecret: converter.Bool(true)}))
+	require.False(t, hasVariableValue(variables, "var2", v5taskagent.VariableValue{Value: converter.String("value"), IsSecret: converter.Bool(false)}))
+}
+
+// verifies that only configured variables are flattened when unmanaged variables are ignored
+func TestVariableGroup_FilterUnmanagedVariables(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceVariableGroup().Schema, nil)
+	resourceData.Set(vgIgnoreUnmanaged, true)
+	resourceData.Set(vgVariable, []interface{}{
+		map[string]interface{}{vgName: "managed", vgValue: "value"},
+	})
+
+	variableGroup := &v5taskagent.VariableGroup{
+		Id: converter.Int(1),
+		Variables: &map[string]interface{}{
+			"managed":   v5taskagent.VariableValue{Value: converter.String("value")},
+			"unmanaged": v5taskagent.VariableValue{Value: converter.String("other")},
+		},
+	}
+
+	filtered := filterUnmanagedVariables(resourceData, variableGroup)
+	require.Len(t, *filtered.Variables, 1)
+	require.Contains(t, *filtered.Variables, "managed")
+	require.Len(t, *variableGroup.Variables, 2)
+
+	resourceData.Set(vgIgnoreUnmanaged, false)
+	require.Equal(t, variableGroup, filterUnmanagedVariables(resourceData, variableGroup))
+}
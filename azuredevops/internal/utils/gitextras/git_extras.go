@@ -0,0 +1,103 @@
+package gitextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+)
+
+// Client covers the git APIs whose models are not fully exposed by the SDK git client
+type Client interface {
+	// Retrieve a git repository, including whether it is disabled.
+	GetRepository(context.Context, GetRepositoryArgs) (*GitRepository, error)
+	// Updates the Git repository, including whether it is disabled.
+	UpdateRepository(context.Context, UpdateRepositoryArgs) (*GitRepository, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, git.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// Retrieve a git repository, including whether it is disabled.
+func (client *ClientImpl) GetRepository(ctx context.Context, args GetRepositoryArgs) (*GitRepository, error) {
+	routeValues := make(map[string]string)
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+	if args.RepositoryId == nil || *args.RepositoryId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.RepositoryId"}
+	}
+	routeValues["repositoryId"] = *args.RepositoryId
+
+	locationId, _ := uuid.Parse("225f7195-f9c7-4d14-ab28-a83f7ff77e1f")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "6.0", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue GitRepository
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetRepository function
+type GetRepositoryArgs struct {
+	// (required) The name or ID of the repository.
+	RepositoryId *string
+	// (optional) Project ID or project name
+	Project *string
+}
+
+// Updates the Git repository, including whether it is disabled.
+func (client *ClientImpl) UpdateRepository(ctx context.Context, args UpdateRepositoryArgs) (*GitRepository, error) {
+	if args.NewRepositoryInfo == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.NewRepositoryInfo"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+	if args.RepositoryId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.RepositoryId"}
+	}
+	routeValues["repositoryId"] = (*args.RepositoryId).String()
+
+	body, marshalErr := json.Marshal(*args.NewRepositoryInfo)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("225f7195-f9c7-4d14-ab28-a83f7ff77e1f")
+	resp, err := client.Client.Send(ctx, http.MethodPatch, locationId, "6.0", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue GitRepository
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateRepository function
+type UpdateRepositoryArgs struct {
+	// (required) The properties of the repository to update
+	NewRepositoryInfo *GitRepository
+	// (required) The ID of the repository.
+	RepositoryId *uuid.UUID
+	// (optional) Project ID or project name
+	Project *string
+}
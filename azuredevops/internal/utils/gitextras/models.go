@@ -0,0 +1,12 @@
+package gitextras
+
+import (
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
+)
+
+// A git repository, including the properties which are not exposed by the SDK model
+type GitRepository struct {
+	git.GitRepository
+	// True if the repository is disabled
+	IsDisabled *bool `json:"isDisabled,omitempty"`
+}
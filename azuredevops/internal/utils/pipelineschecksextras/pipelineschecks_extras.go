@@ -1,7 +1,9 @@
 package pipelineschecksextras
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -13,9 +15,18 @@ import (
 
 var ResourceAreaId, _ = uuid.Parse("4a933897-0488-45af-bd82-6fd3ad33f46a")
 
+// Client extends the SDK pipelineschecks client. Unlike the SDK client, the project
+// argument is optional: when it is omitted the request is sent to the organization
+// scoped route, which is required for organization level resources like agent pools.
 type Client interface {
+	// [Preview API] Add a check configuration
+	AddCheckConfiguration(context.Context, pipelineschecks.AddCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error)
+	// [Preview API] Delete check configuration by id
+	DeleteCheckConfiguration(context.Context, pipelineschecks.DeleteCheckConfigurationArgs) error
 	// [Preview API] Get Check configuration by Id
 	GetCheckConfiguration(context.Context, pipelineschecks.GetCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error)
+	// [Preview API] Update check configuration
+	UpdateCheckConfiguration(context.Context, pipelineschecks.UpdateCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error)
 }
 
 type ClientImpl struct {
@@ -32,13 +43,53 @@ func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client,
 	}, nil
 }
 
+// [Preview API] Add a check configuration
+func (client *ClientImpl) AddCheckConfiguration(ctx context.Context, args pipelineschecks.AddCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error) {
+	if args.Configuration == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Configuration"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+
+	body, marshalErr := json.Marshal(*args.Configuration)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("86c8381e-5aee-4cde-8ae4-25c0c7f5eaea")
+	resp, err := client.Client.Send(ctx, http.MethodPost, locationId, "5.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue pipelineschecks.CheckConfiguration
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// [Preview API] Delete check configuration by id
+func (client *ClientImpl) DeleteCheckConfiguration(ctx context.Context, args pipelineschecks.DeleteCheckConfigurationArgs) error {
+	routeValues := make(map[string]string)
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+	if args.Id == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues["id"] = strconv.Itoa(*args.Id)
+
+	locationId, _ := uuid.Parse("86c8381e-5aee-4cde-8ae4-25c0c7f5eaea")
+	_, err := client.Client.Send(ctx, http.MethodDelete, locationId, "5.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
 // [Preview API] Get Check configuration by Id
 func (client *ClientImpl) GetCheckConfiguration(ctx context.Context, args pipelineschecks.GetCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error) {
 	routeValues := make(map[string]string)
-	if args.Project == nil || *args.Project == "" {
-		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
 	}
-	routeValues["project"] = *args.Project
 	if args.Id == nil {
 		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
 	}
@@ -57,3 +108,32 @@ func (client *ClientImpl) GetCheckConfiguration(ctx context.Context, args pipeli
 	err = client.Client.UnmarshalBody(resp, &responseValue)
 	return &responseValue, err
 }
+
+// [Preview API] Update check configuration
+func (client *ClientImpl) UpdateCheckConfiguration(ctx context.Context, args pipelineschecks.UpdateCheckConfigurationArgs) (*pipelineschecks.CheckConfiguration, error) {
+	if args.Configuration == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Configuration"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project != nil && *args.Project != "" {
+		routeValues["project"] = *args.Project
+	}
+	if args.Id == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues["id"] = strconv.Itoa(*args.Id)
+
+	body, marshalErr := json.Marshal(*args.Configuration)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("86c8381e-5aee-4cde-8ae4-25c0c7f5eaea")
+	resp, err := client.Client.Send(ctx, http.MethodPatch, locationId, "5.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue pipelineschecks.CheckConfiguration
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
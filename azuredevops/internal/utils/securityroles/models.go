@@ -0,0 +1,61 @@
+package securityroles
+
+import (
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+)
+
+// RoleAccess describes how a role assignment was granted
+type RoleAccess string
+
+type roleAccessValuesType struct {
+	Assigned  RoleAccess
+	Inherited RoleAccess
+}
+
+var RoleAccessValues = roleAccessValuesType{
+	// Access has been explicitly assigned on the resource.
+	Assigned: "assigned",
+	// Access has been inherited from a higher-level scope.
+	Inherited: "inherited",
+}
+
+// RoleAssignment a role assigned to an identity on a resource
+type RoleAssignment struct {
+	// Designates the role as explicitly assigned or inherited.
+	Access *RoleAccess `json:"access,omitempty"`
+	// User friendly description of access assignment.
+	AccessDisplayName *string `json:"accessDisplayName,omitempty"`
+	// The user to whom the role is assigned.
+	Identity *webapi.IdentityRef `json:"identity,omitempty"`
+	// The role assigned to the user.
+	Role *SecurityRole `json:"role,omitempty"`
+}
+
+// SecurityRole a role available within a security role scope
+type SecurityRole struct {
+	// Permissions the role is allowed.
+	AllowPermissions *int `json:"allowPermissions,omitempty"`
+	// Permissions the role is denied.
+	DenyPermissions *int `json:"denyPermissions,omitempty"`
+	// Description of user access defined by the role
+	Description *string `json:"description,omitempty"`
+	// User friendly name of the role.
+	DisplayName *string `json:"displayName,omitempty"`
+	// Globally unique identifier for the role.
+	Identifier *string `json:"identifier,omitempty"`
+	// Unique name of the role in the scope.
+	Name *string `json:"name,omitempty"`
+	// Returns the id of the ParentGroup.
+	Scope *string `json:"scope,omitempty"`
+}
+
+// UserRoleAssignmentRef a role to assign to an identity
+type UserRoleAssignmentRef struct {
+	// The name of the role assigned.
+	RoleName *string `json:"roleName,omitempty"`
+	// Identifier of the user given the role assignment.
+	UniqueName *string `json:"uniqueName,omitempty"`
+	// Unique id of the user given the role assignment.
+	UserId *uuid.UUID `json:"userId,omitempty"`
+}
@@ -0,0 +1,165 @@
+package securityroles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+)
+
+// The security roles routes are not registered as API resource locations on all
+// Azure DevOps instances, so the requests are sent to the organization URL directly.
+const apiVersion = "6.0-preview.1"
+
+// Client covers the security role assignment APIs, which are not exposed by the SDK
+type Client interface {
+	// [Preview API] Get the role assignments of a resource within a scope
+	GetRoleAssignments(context.Context, GetRoleAssignmentsArgs) (*[]RoleAssignment, error)
+	// [Preview API] Remove the role assignments of the given identities from a resource
+	RemoveRoleAssignments(context.Context, RemoveRoleAssignmentsArgs) error
+	// [Preview API] Enable or disable the inheritance of role assignments from the parent scope
+	SetRoleAssignmentInheritance(context.Context, SetRoleAssignmentInheritanceArgs) error
+	// [Preview API] Add or update the role assignments of a resource
+	SetRoleAssignments(context.Context, SetRoleAssignmentsArgs) (*[]RoleAssignment, error)
+}
+
+type ClientImpl struct {
+	Client  azuredevops.Client
+	BaseUrl string
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) Client {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client:  *client,
+		BaseUrl: connection.BaseUrl,
+	}
+}
+
+// [Preview API] Get the role assignments of a resource within a scope
+func (client *ClientImpl) GetRoleAssignments(ctx context.Context, args GetRoleAssignmentsArgs) (*[]RoleAssignment, error) {
+	resp, err := client.send(ctx, http.MethodGet, args.ScopeId, args.ResourceId, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []RoleAssignment
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetRoleAssignments function
+type GetRoleAssignmentsArgs struct {
+	// (required) Id of the security role scope
+	ScopeId *string
+	// (required) Id of the resource within the scope
+	ResourceId *string
+}
+
+// [Preview API] Remove the role assignments of the given identities from a resource
+func (client *ClientImpl) RemoveRoleAssignments(ctx context.Context, args RemoveRoleAssignmentsArgs) error {
+	if args.IdentityIds == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.IdentityIds"}
+	}
+
+	_, err := client.send(ctx, http.MethodPatch, args.ScopeId, args.ResourceId, nil, *args.IdentityIds)
+	return err
+}
+
+// Arguments for the RemoveRoleAssignments function
+type RemoveRoleAssignmentsArgs struct {
+	// (required) Id of the security role scope
+	ScopeId *string
+	// (required) Id of the resource within the scope
+	ResourceId *string
+	// (required) Ids of the identities to remove the role assignments of
+	IdentityIds *[]uuid.UUID
+}
+
+// [Preview API] Enable or disable the inheritance of role assignments from the parent scope
+func (client *ClientImpl) SetRoleAssignmentInheritance(ctx context.Context, args SetRoleAssignmentInheritanceArgs) error {
+	if args.InheritPermissions == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.InheritPermissions"}
+	}
+	queryParams := url.Values{}
+	queryParams.Add("inheritPermissions", strconv.FormatBool(*args.InheritPermissions))
+
+	_, err := client.send(ctx, http.MethodPatch, args.ScopeId, args.ResourceId, queryParams, []uuid.UUID{})
+	return err
+}
+
+// Arguments for the SetRoleAssignmentInheritance function
+type SetRoleAssignmentInheritanceArgs struct {
+	// (required) Id of the security role scope
+	ScopeId *string
+	// (required) Id of the resource within the scope
+	ResourceId *string
+	// (required) Whether role assignments are inherited from the parent scope
+	InheritPermissions *bool
+}
+
+// [Preview API] Add or update the role assignments of a resource
+func (client *ClientImpl) SetRoleAssignments(ctx context.Context, args SetRoleAssignmentsArgs) (*[]RoleAssignment, error) {
+	if args.Assignments == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Assignments"}
+	}
+
+	resp, err := client.send(ctx, http.MethodPut, args.ScopeId, args.ResourceId, nil, *args.Assignments)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []RoleAssignment
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the SetRoleAssignments function
+type SetRoleAssignmentsArgs struct {
+	// (required) Id of the security role scope
+	ScopeId *string
+	// (required) Id of the resource within the scope
+	ResourceId *string
+	// (required) Roles to assign
+	Assignments *[]UserRoleAssignmentRef
+}
+
+func (client *ClientImpl) send(ctx context.Context, httpMethod string, scopeId, resourceId *string, queryParams url.Values, body interface{}) (*http.Response, error) {
+	if scopeId == nil || *scopeId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ScopeId"}
+	}
+	if resourceId == nil || *resourceId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ResourceId"}
+	}
+
+	requestUrl := fmt.Sprintf("%s/_apis/securityroles/scopes/%s/roleassignments/resources/%s",
+		strings.TrimRight(client.BaseUrl, "/"), url.PathEscape(*scopeId), url.PathEscape(*resourceId))
+	if len(queryParams) > 0 {
+		requestUrl += "?" + queryParams.Encode()
+	}
+
+	var bodyReader io.Reader
+	mediaType := ""
+	if body != nil {
+		content, marshalErr := json.Marshal(body)
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		bodyReader = bytes.NewReader(content)
+		mediaType = "application/json"
+	}
+
+	req, err := client.Client.CreateRequestMessage(ctx, httpMethod, requestUrl, apiVersion, bodyReader, mediaType, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Client.SendRequest(req)
+}
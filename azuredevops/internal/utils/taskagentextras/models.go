@@ -0,0 +1,83 @@
+package taskagentextras
+
+import (
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+)
+
+// Data and settings for an elastic pool
+type ElasticPool struct {
+	// Set whether agents should be configured to run with interactive UI
+	AgentInteractiveUI *bool `json:"agentInteractiveUI,omitempty"`
+	// Azure string representing to location of the resource
+	AzureId *string `json:"azureId,omitempty"`
+	// Number of agents to have ready waiting for jobs
+	DesiredIdle *int `json:"desiredIdle,omitempty"`
+	// The desired size of the pool
+	DesiredSize *int `json:"desiredSize,omitempty"`
+	// Maximum number of nodes that will exist in the elastic pool
+	MaxCapacity *int `json:"maxCapacity,omitempty"`
+	// Timestamp the pool was first detected to be offline
+	OfflineSince *azuredevops.Time `json:"offlineSince,omitempty"`
+	// Operating system type of the nodes in the pool
+	OsType *taskagent.OperatingSystemType `json:"osType,omitempty"`
+	// Id of the associated TaskAgentPool
+	PoolId *int `json:"poolId,omitempty"`
+	// Discard node after each job completes
+	RecycleAfterEachUse *bool `json:"recycleAfterEachUse,omitempty"`
+	// Id of the Service Endpoint used to connect to Azure
+	ServiceEndpointId *uuid.UUID `json:"serviceEndpointId,omitempty"`
+	// Scope the Service Endpoint belongs to
+	ServiceEndpointScope *uuid.UUID `json:"serviceEndpointScope,omitempty"`
+	// The number of sizing attempts executed while trying to achieve a desired size
+	SizingAttempts *int `json:"sizingAttempts,omitempty"`
+	// State of the pool
+	State *taskagent.ElasticPoolState `json:"state,omitempty"`
+	// The minimum time in minutes to keep idle agents alive
+	TimeToLiveMinutes *int `json:"timeToLiveMinutes,omitempty"`
+}
+
+// Returned result from creating a new elastic pool
+type ElasticPoolCreationResult struct {
+	// Created agent pool
+	AgentPool *taskagent.TaskAgentPool `json:"agentPool,omitempty"`
+	// Created agent queue
+	AgentQueue *taskagent.TaskAgentQueue `json:"agentQueue,omitempty"`
+	// Created elastic pool
+	ElasticPool *ElasticPool `json:"elasticPool,omitempty"`
+}
+
+// New elastic pool settings
+type ElasticPoolSettings struct {
+	// Set whether agents should be configured to run with interactive UI
+	AgentInteractiveUI *bool `json:"agentInteractiveUI,omitempty"`
+	// Azure string representing to location of the resource
+	AzureId *string `json:"azureId,omitempty"`
+	// Number of machines to have ready waiting for jobs
+	DesiredIdle *int `json:"desiredIdle,omitempty"`
+	// Maximum number of machines that will exist in the elastic pool
+	MaxCapacity *int `json:"maxCapacity,omitempty"`
+	// Operating system type of the machines in the pool
+	OsType *taskagent.OperatingSystemType `json:"osType,omitempty"`
+	// Discard machines after each job completes
+	RecycleAfterEachUse *bool `json:"recycleAfterEachUse,omitempty"`
+	// Id of the Service Endpoint used to connect to Azure
+	ServiceEndpointId *uuid.UUID `json:"serviceEndpointId,omitempty"`
+	// Scope the Service Endpoint belongs to
+	ServiceEndpointScope *uuid.UUID `json:"serviceEndpointScope,omitempty"`
+	// The minimum time in minutes to keep idle agents alive
+	TimeToLiveMinutes *int `json:"timeToLiveMinutes,omitempty"`
+}
+
+// Parameters to publish a draft of a task group as a new version of its parent
+type TaskGroupPublishParameter struct {
+	// Comment for the publish request
+	Comment *string `json:"comment,omitempty"`
+	// Denotes if the new version is published as preview
+	Preview *bool `json:"preview,omitempty"`
+	// Id of the draft task group that is getting published
+	TaskGroupId *uuid.UUID `json:"taskGroupId,omitempty"`
+	// Revision of the draft task group that is getting published
+	TaskGroupRevision *int `json:"taskGroupRevision,omitempty"`
+}
@@ -0,0 +1,592 @@
+package taskagentextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+)
+
+var ResourceAreaId, _ = uuid.Parse("a85b8835-c1a1-4aac-ae97-1c3d0ba72dbd")
+
+// Client covers the distributed task APIs that are not exposed by the SDK taskagent client
+type Client interface {
+	// [Preview API] Add a kubernetes resource, using an existing service endpoint, to an environment
+	AddKubernetesResource(context.Context, AddKubernetesResourceArgs) (*taskagent.KubernetesResource, error)
+	// [Preview API] Create a maintenance definition for an agent pool
+	CreateAgentPoolMaintenanceDefinition(context.Context, CreateAgentPoolMaintenanceDefinitionArgs) (*taskagent.TaskAgentPoolMaintenanceDefinition, error)
+	// [Preview API] Create a new elastic pool. This will create a new TaskAgentPool at the organization level. If a project id is provided, this will create a new TaskAgentQueue in the specified project.
+	CreateElasticPool(context.Context, CreateElasticPoolArgs) (*ElasticPoolCreationResult, error)
+	// [Preview API] Delete a maintenance definition of an agent pool
+	DeleteAgentPoolMaintenanceDefinition(context.Context, DeleteAgentPoolMaintenanceDefinitionArgs) error
+	// [Preview API] Delete a secure file
+	DeleteSecureFile(context.Context, DeleteSecureFileArgs) error
+	// [Preview API] Get the maintenance definitions of an agent pool
+	GetAgentPoolMaintenanceDefinitions(context.Context, GetAgentPoolMaintenanceDefinitionsArgs) (*[]taskagent.TaskAgentPoolMaintenanceDefinition, error)
+	// [Preview API] Returns the Elastic Pool with the specified Pool Id.
+	GetElasticPool(context.Context, GetElasticPoolArgs) (*ElasticPool, error)
+	// [Preview API] Get a secure file
+	GetSecureFile(context.Context, GetSecureFileArgs) (*taskagent.SecureFile, error)
+	// [Preview API] Get secure files
+	GetSecureFiles(context.Context, GetSecureFilesArgs) (*[]taskagent.SecureFile, error)
+	// [Preview API] Publish a preview version of a task group, or mark a version as preview
+	PublishPreviewTaskGroup(context.Context, PublishPreviewTaskGroupArgs) (*[]taskagent.TaskGroup, error)
+	// [Preview API] Publish a draft of a task group as a new major version of its parent task group
+	PublishTaskGroup(context.Context, PublishTaskGroupArgs) (*[]taskagent.TaskGroup, error)
+	// [Preview API] Update a maintenance definition of an agent pool
+	UpdateAgentPoolMaintenanceDefinition(context.Context, UpdateAgentPoolMaintenanceDefinitionArgs) (*taskagent.TaskAgentPoolMaintenanceDefinition, error)
+	// [Preview API] Update settings on a specified Elastic Pool.
+	UpdateElasticPool(context.Context, UpdateElasticPoolArgs) (*ElasticPool, error)
+	// [Preview API] Update the name or properties of an existing secure file
+	UpdateSecureFile(context.Context, UpdateSecureFileArgs) (*taskagent.SecureFile, error)
+	// [Preview API] Upload a secure file, include the file stream in the request body
+	UploadSecureFile(context.Context, UploadSecureFileArgs) (*taskagent.SecureFile, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Delete a secure file
+func (client *ClientImpl) DeleteSecureFile(ctx context.Context, args DeleteSecureFileArgs) error {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	locationId, _ := uuid.Parse("adcfd8bc-b184-43ba-bd84-7c8c6a2ff421")
+	_, err := client.Client.Send(ctx, http.MethodDelete, locationId, "6.0-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// Arguments for the DeleteSecureFile function
+type DeleteSecureFileArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Get a secure file
+func (client *ClientImpl) GetSecureFile(ctx context.Context, args GetSecureFileArgs) (*taskagent.SecureFile, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	locationId, _ := uuid.Parse("adcfd8bc-b184-43ba-bd84-7c8c6a2ff421")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "6.0-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetSecureFile function
+type GetSecureFileArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Get secure files
+func (client *ClientImpl) GetSecureFiles(ctx context.Context, args GetSecureFilesArgs) (*[]taskagent.SecureFile, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	if args.NamePattern != nil {
+		queryParams.Add("namePattern", *args.NamePattern)
+	}
+	locationId, _ := uuid.Parse("adcfd8bc-b184-43ba-bd84-7c8c6a2ff421")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "6.0-preview.1", routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []taskagent.SecureFile
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetSecureFiles function
+type GetSecureFilesArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (optional) Name of the secure file to match. Can include wildcards to match multiple files.
+	NamePattern *string
+}
+
+// [Preview API] Update the name or properties of an existing secure file
+func (client *ClientImpl) UpdateSecureFile(ctx context.Context, args UpdateSecureFileArgs) (*taskagent.SecureFile, error) {
+	if args.SecureFile == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFile"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	body, marshalErr := json.Marshal(*args.SecureFile)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("adcfd8bc-b184-43ba-bd84-7c8c6a2ff421")
+	resp, err := client.Client.Send(ctx, http.MethodPatch, locationId, "6.0-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateSecureFile function
+type UpdateSecureFileArgs struct {
+	// (required) The secure file with updated name and/or properties
+	SecureFile *taskagent.SecureFile
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Upload a secure file, include the file stream in the request body
+func (client *ClientImpl) UploadSecureFile(ctx context.Context, args UploadSecureFileArgs) (*taskagent.SecureFile, error) {
+	if args.UploadStream == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.UploadStream"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	if args.Name == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Name"}
+	}
+	queryParams.Add("name", *args.Name)
+	if args.AuthorizePipelines != nil {
+		queryParams.Add("authorizePipelines", strconv.FormatBool(*args.AuthorizePipelines))
+	}
+	locationId, _ := uuid.Parse("adcfd8bc-b184-43ba-bd84-7c8c6a2ff421")
+	resp, err := client.Client.Send(ctx, http.MethodPost, locationId, "6.0-preview.1", routeValues, queryParams, args.UploadStream, "application/octet-stream", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UploadSecureFile function
+type UploadSecureFileArgs struct {
+	// (required) Stream to upload
+	UploadStream io.Reader
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name of the file to upload
+	Name *string
+	// (optional) If authorizePipelines is true, then the secure file is authorized for use by all pipelines in the project.
+	AuthorizePipelines *bool
+}
+
+// [Preview API] Create a new elastic pool. This will create a new TaskAgentPool at the organization level. If a project id is provided, this will create a new TaskAgentQueue in the specified project.
+func (client *ClientImpl) CreateElasticPool(ctx context.Context, args CreateElasticPoolArgs) (*ElasticPoolCreationResult, error) {
+	if args.ElasticPool == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.ElasticPool"}
+	}
+	queryParams := url.Values{}
+	if args.PoolName == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PoolName"}
+	}
+	queryParams.Add("poolName", *args.PoolName)
+	if args.AuthorizeAllPipelines != nil {
+		queryParams.Add("authorizeAllPipelines", strconv.FormatBool(*args.AuthorizeAllPipelines))
+	}
+	if args.AutoProvisionProjectPools != nil {
+		queryParams.Add("autoProvisionProjectPools", strconv.FormatBool(*args.AutoProvisionProjectPools))
+	}
+	if args.ProjectId != nil {
+		queryParams.Add("projectId", (*args.ProjectId).String())
+	}
+	body, marshalErr := json.Marshal(*args.ElasticPool)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("dd3c938f-835b-4971-b99a-db75a47aad43")
+	resp, err := client.Client.Send(ctx, http.MethodPost, locationId, "7.1-preview.1", nil, queryParams, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue ElasticPoolCreationResult
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the CreateElasticPool function
+type CreateElasticPoolArgs struct {
+	// (required) Elastic pool to create. Contains the properties necessary for configuring a new ElasticPool.
+	ElasticPool *ElasticPool
+	// (required) Name to use for the new TaskAgentPool
+	PoolName *string
+	// (optional) Setting to determine if all pipelines are authorized to use this TaskAgentPool by default.
+	AuthorizeAllPipelines *bool
+	// (optional) Setting to automatically provision TaskAgentQueues in every project for the new pool.
+	AutoProvisionProjectPools *bool
+	// (optional) Optional: If provided, a new TaskAgentQueue will be created in the specified project.
+	ProjectId *uuid.UUID
+}
+
+// [Preview API] Returns the Elastic Pool with the specified Pool Id.
+func (client *ClientImpl) GetElasticPool(ctx context.Context, args GetElasticPoolArgs) (*ElasticPool, error) {
+	routeValues := make(map[string]string)
+	if args.PoolId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PoolId"}
+	}
+	routeValues["poolId"] = strconv.Itoa(*args.PoolId)
+
+	locationId, _ := uuid.Parse("dd3c938f-835b-4971-b99a-db75a47aad43")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "7.1-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue ElasticPool
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetElasticPool function
+type GetElasticPoolArgs struct {
+	// (required) Pool Id of the associated TaskAgentPool
+	PoolId *int
+}
+
+// [Preview API] Update settings on a specified Elastic Pool.
+func (client *ClientImpl) UpdateElasticPool(ctx context.Context, args UpdateElasticPoolArgs) (*ElasticPool, error) {
+	if args.ElasticPoolSettings == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.ElasticPoolSettings"}
+	}
+	routeValues := make(map[string]string)
+	if args.PoolId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PoolId"}
+	}
+	routeValues["poolId"] = strconv.Itoa(*args.PoolId)
+
+	body, marshalErr := json.Marshal(*args.ElasticPoolSettings)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("dd3c938f-835b-4971-b99a-db75a47aad43")
+	resp, err := client.Client.Send(ctx, http.MethodPatch, locationId, "7.1-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue ElasticPool
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateElasticPool function
+type UpdateElasticPoolArgs struct {
+	// (required) New Elastic Pool settings data
+	ElasticPoolSettings *ElasticPoolSettings
+	// (required)
+	PoolId *int
+}
+
+// [Preview API] Add a kubernetes resource, using an existing service endpoint, to an environment
+func (client *ClientImpl) AddKubernetesResource(ctx context.Context, args AddKubernetesResourceArgs) (*taskagent.KubernetesResource, error) {
+	if args.CreateParameters == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.CreateParameters"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.EnvironmentId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	routeValues["environmentId"] = strconv.Itoa(*args.EnvironmentId)
+
+	body, marshalErr := json.Marshal(*args.CreateParameters)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("73fba52f-15ab-42b3-a538-ce67a9223a04")
+	resp, err := client.Client.Send(ctx, http.MethodPost, locationId, "6.0-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.KubernetesResource
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the AddKubernetesResource function
+type AddKubernetesResourceArgs struct {
+	// (required) The kubernetes resource, referencing the service endpoint used to connect to the cluster
+	CreateParameters *taskagent.KubernetesResourceCreateParametersExistingEndpoint
+	// (required) Project ID or project name
+	Project *string
+	// (required) ID of the environment
+	EnvironmentId *int
+}
+
+// [Preview API] Create a maintenance definition for an agent pool
+func (client *ClientImpl) CreateAgentPoolMaintenanceDefinition(ctx context.Context, args CreateAgentPoolMaintenanceDefinitionArgs) (*taskagent.TaskAgentPoolMaintenanceDefinition, error) {
+	if args.Definition == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Definition"}
+	}
+	routeValues := make(map[string]string)
+	if args.PoolId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PoolId"}
+	}
+	routeValues["poolId"] = strconv.Itoa(*args.PoolId)
+
+	body, marshalErr := json.Marshal(*args.Definition)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("80572e16-58f0-4419-ac07-d19fde32195c")
+	resp, err := client.Client.Send(ctx, http.MethodPost, locationId, "6.0-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.TaskAgentPoolMaintenanceDefinition
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the CreateAgentPoolMaintenanceDefinition function
+type CreateAgentPoolMaintenanceDefinitionArgs struct {
+	// (required) The maintenance definition to create
+	Definition *taskagent.TaskAgentPoolMaintenanceDefinition
+	// (required) ID of the agent pool
+	PoolId *int
+}
+
+// [Preview API] Delete a maintenance definition of an agent pool
+func (client *ClientImpl) DeleteAgentPoolMaintenanceDefinition(ctx context.Context, args DeleteAgentPoolMaintenanceDefinitionArgs) error {
+	routeValues := make(map[string]string)
+	if args.PoolId == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.PoolId"}
+	}
+	routeValues["poolId"] = strconv.Itoa(*args.PoolId)
+	if args.DefinitionId == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.DefinitionId"}
+	}
+	routeValues["definitionId"] = strconv.Itoa(*args.DefinitionId)
+
+	locationId, _ := uuid.Parse("80572e16-58f0-4419-ac07-d19fde32195c")
+	_, err := client.Client.Send(ctx, http.MethodDelete, locationId, "6.0-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// Arguments for the DeleteAgentPoolMaintenanceDefinition function
+type DeleteAgentPoolMaintenanceDefinitionArgs struct {
+	// (required) ID of the agent pool
+	PoolId *int
+	// (required) ID of the maintenance definition
+	DefinitionId *int
+}
+
+// [Preview API] Get the maintenance definitions of an agent pool
+func (client *ClientImpl) GetAgentPoolMaintenanceDefinitions(ctx context.Context, args GetAgentPoolMaintenanceDefinitionsArgs) (*[]taskagent.TaskAgentPoolMaintenanceDefinition, error) {
+	routeValues := make(map[string]string)
+	if args.PoolId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PoolId"}
+	}
+	routeValues["poolId"] = strconv.Itoa(*args.PoolId)
+
+	locationId, _ := uuid.Parse("80572e16-58f0-4419-ac07-d19fde32195c")
+	resp, err := client.Client.Send(ctx, http.MethodGet, locationId, "6.0-preview.1", routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []taskagent.TaskAgentPoolMaintenanceDefinition
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetAgentPoolMaintenanceDefinitions function
+type GetAgentPoolMaintenanceDefinitionsArgs struct {
+	// (required) ID of the agent pool
+	PoolId *int
+}
+
+// [Preview API] Update a maintenance definition of an agent pool
+func (client *ClientImpl) UpdateAgentPoolMaintenanceDefinition(ctx context.Context, args UpdateAgentPoolMaintenanceDefinitionArgs) (*taskagent.TaskAgentPoolMaintenanceDefinition, error) {
+	if args.Definition == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Definition"}
+	}
+	routeValues := make(map[string]string)
+	if args.PoolId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.PoolId"}
+	}
+	routeValues["poolId"] = strconv.Itoa(*args.PoolId)
+	if args.DefinitionId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.DefinitionId"}
+	}
+	routeValues["definitionId"] = strconv.Itoa(*args.DefinitionId)
+
+	body, marshalErr := json.Marshal(*args.Definition)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("80572e16-58f0-4419-ac07-d19fde32195c")
+	resp, err := client.Client.Send(ctx, http.MethodPut, locationId, "6.0-preview.1", routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.TaskAgentPoolMaintenanceDefinition
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateAgentPoolMaintenanceDefinition function
+type UpdateAgentPoolMaintenanceDefinitionArgs struct {
+	// (required) The updated maintenance definition
+	Definition *taskagent.TaskAgentPoolMaintenanceDefinition
+	// (required) ID of the agent pool
+	PoolId *int
+	// (required) ID of the maintenance definition
+	DefinitionId *int
+}
+
+// [Preview API] Publish a draft of a task group as a new major version of its parent task group
+func (client *ClientImpl) PublishTaskGroup(ctx context.Context, args PublishTaskGroupArgs) (*[]taskagent.TaskGroup, error) {
+	if args.TaskGroupPublishParameter == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroupPublishParameter"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	if args.ParentTaskGroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.ParentTaskGroupId"}
+	}
+	queryParams.Add("parentTaskGroupId", (*args.ParentTaskGroupId).String())
+	body, marshalErr := json.Marshal(*args.TaskGroupPublishParameter)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("6c08ffbf-dbf1-4f9a-94e5-a1cbd47005e7")
+	resp, err := client.Client.Send(ctx, http.MethodPut, locationId, "6.0-preview.1", routeValues, queryParams, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []taskagent.TaskGroup
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the PublishTaskGroup function
+type PublishTaskGroupArgs struct {
+	// (required) The draft task group to publish
+	TaskGroupPublishParameter *TaskGroupPublishParameter
+	// (required) Project ID or project name
+	Project *string
+	// (required) Id of the parent task group
+	ParentTaskGroupId *uuid.UUID
+}
+
+// [Preview API] Publish a preview version of a task group, or mark a version as preview
+func (client *ClientImpl) PublishPreviewTaskGroup(ctx context.Context, args PublishPreviewTaskGroupArgs) (*[]taskagent.TaskGroup, error) {
+	if args.TaskGroup == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroup"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.TaskGroupId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.TaskGroupId"}
+	}
+	routeValues["taskGroupId"] = (*args.TaskGroupId).String()
+
+	queryParams := url.Values{}
+	if args.DisablePriorVersions != nil {
+		queryParams.Add("disablePriorVersions", strconv.FormatBool(*args.DisablePriorVersions))
+	}
+	body, marshalErr := json.Marshal(*args.TaskGroup)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	locationId, _ := uuid.Parse("6c08ffbf-dbf1-4f9a-94e5-a1cbd47005e7")
+	resp, err := client.Client.Send(ctx, http.MethodPatch, locationId, "6.0-preview.1", routeValues, queryParams, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []taskagent.TaskGroup
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the PublishPreviewTaskGroup function
+type PublishPreviewTaskGroupArgs struct {
+	// (required) The version of the task group to publish
+	TaskGroup *taskagent.TaskGroupPublishPreviewParameter
+	// (required) Project ID or project name
+	Project *string
+	// (required) Id of the task group
+	TaskGroupId *uuid.UUID
+	// (optional) 'true' to disable all previous versions of the task group
+	DisablePriorVersions *bool
+}
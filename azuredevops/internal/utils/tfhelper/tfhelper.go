@@ -37,6 +37,10 @@ func ParseGitRepoBranchID(id string) (string, string, error) {
 	return parseTwoPartID(id, ":", "repositoryID:branchName")
 }
 
+func ParseGitRepoTagID(id string) (string, string, error) {
+	return parseTwoPartID(id, ":", "repositoryID:tagName")
+}
+
 func parseTwoPartID(id, sep, want string) (string, string, error) {
 	parts := strings.SplitN(id, sep, 2)
 	if len(parts) != 2 || strings.EqualFold(parts[0], "") || strings.EqualFold(parts[1], "") {
@@ -66,6 +66,8 @@ func Provider() *schema.Provider {
 			"azuredevops_project_features":                       core.ResourceProjectFeatures(),
 			"azuredevops_project_pipeline_settings":              core.ResourceProjectPipelineSettings(),
 			"azuredevops_variable_group":                         taskagent.ResourceVariableGroup(),
+			"azuredevops_variable_group_variable":                taskagent.ResourceVariableGroupVariable(),
+			"azuredevops_secure_file":                            taskagent.ResourceSecureFile(),
 			"azuredevops_repository_policy_author_email_pattern": repository.ResourceRepositoryPolicyAuthorEmailPatterns(),
 			"azuredevops_repository_policy_file_path_pattern":    repository.ResourceRepositoryFilePathPatterns(),
 			"azuredevops_repository_policy_case_enforcement":     repository.ResourceRepositoryEnforceConsistentCase(),
@@ -105,9 +107,16 @@ func Provider() *schema.Provider {
 			"azuredevops_git_repository":                         git.ResourceGitRepository(),
 			"azuredevops_git_repository_branch":                  git.ResourceGitRepositoryBranch(),
 			"azuredevops_git_repository_file":                    git.ResourceGitRepositoryFile(),
+			"azuredevops_git_repository_files":                   git.ResourceGitRepositoryFiles(),
+			"azuredevops_git_repository_directory":               git.ResourceGitRepositoryDirectory(),
+			"azuredevops_git_repository_tag":                     git.ResourceGitRepositoryTag(),
+			"azuredevops_git_fork_sync":                          git.ResourceGitForkSync(),
 			"azuredevops_user_entitlement":                       memberentitlementmanagement.ResourceUserEntitlement(),
 			"azuredevops_group_membership":                       graph.ResourceGroupMembership(),
 			"azuredevops_agent_pool":                             taskagent.ResourceAgentPool(),
+			"azuredevops_elastic_pool":                           taskagent.ResourceElasticPool(),
+			"azuredevops_deployment_group":                       taskagent.ResourceDeploymentGroup(),
+			"azuredevops_task_group":                             taskagent.ResourceTaskGroup(),
 			"azuredevops_agent_queue":                            taskagent.ResourceAgentQueue(),
 			"azuredevops_group":                                  graph.ResourceGroup(),
 			"azuredevops_project_permissions":                    permissions.ResourceProjectPermissions(),
@@ -124,29 +133,46 @@ func Provider() *schema.Provider {
 			"azuredevops_servicehook_permissions":                permissions.ResourceServiceHookPermissions(),
 			"azuredevops_tagging_permissions":                    permissions.ResourceTaggingPermissions(),
 			"azuredevops_variable_group_permissions":             permissions.ResourceVariableGroupPermissions(),
+			"azuredevops_agent_pool_role_assignments":            permissions.ResourceAgentPoolRoleAssignments(),
+			"azuredevops_agent_queue_role_assignments":           permissions.ResourceAgentQueueRoleAssignments(),
+			"azuredevops_environment_role_assignments":           permissions.ResourceEnvironmentRoleAssignments(),
+			"azuredevops_variable_group_role_assignments":        permissions.ResourceVariableGroupRoleAssignments(),
+			"azuredevops_serviceendpoint_role_assignments":       permissions.ResourceServiceEndpointRoleAssignments(),
 			"azuredevops_environment":                            taskagent.ResourceEnvironment(),
+			"azuredevops_environment_resource_kubernetes":        taskagent.ResourceEnvironmentKubernetes(),
+			"azuredevops_environment_permissions":                permissions.ResourceEnvironmentPermissions(),
 			"azuredevops_workitem":                               workitemtracking.ResourceWorkItem(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"azuredevops_build_definition":        build.DataBuildDefinition(),
-			"azuredevops_agent_pool":              taskagent.DataAgentPool(),
-			"azuredevops_agent_pools":             taskagent.DataAgentPools(),
-			"azuredevops_agent_queue":             taskagent.DataAgentQueue(),
-			"azuredevops_client_config":           service.DataClientConfig(),
-			"azuredevops_group":                   graph.DataGroup(),
-			"azuredevops_project":                 core.DataProject(),
-			"azuredevops_projects":                core.DataProjects(),
-			"azuredevops_git_repositories":        git.DataGitRepositories(),
-			"azuredevops_git_repository":          git.DataGitRepository(),
-			"azuredevops_users":                   graph.DataUsers(),
-			"azuredevops_area":                    workitemtracking.DataArea(),
-			"azuredevops_iteration":               workitemtracking.DataIteration(),
-			"azuredevops_team":                    core.DataTeam(),
-			"azuredevops_teams":                   core.DataTeams(),
-			"azuredevops_groups":                  graph.DataGroups(),
-			"azuredevops_variable_group":          taskagent.DataVariableGroup(),
-			"azuredevops_serviceendpoint_azurerm": serviceendpoint.DataServiceEndpointAzureRM(),
-			"azuredevops_serviceendpoint_github":  serviceendpoint.DataServiceEndpointGithub(),
+			"azuredevops_build_definition":                      build.DataBuildDefinition(),
+			"azuredevops_agent_pool":                            taskagent.DataAgentPool(),
+			"azuredevops_agent_pools":                           taskagent.DataAgentPools(),
+			"azuredevops_agent_queue":                           taskagent.DataAgentQueue(),
+			"azuredevops_agent_queues":                          taskagent.DataAgentQueues(),
+			"azuredevops_agents":                                taskagent.DataAgents(),
+			"azuredevops_client_config":                         service.DataClientConfig(),
+			"azuredevops_group":                                 graph.DataGroup(),
+			"azuredevops_project":                               core.DataProject(),
+			"azuredevops_projects":                              core.DataProjects(),
+			"azuredevops_git_repositories":                      git.DataGitRepositories(),
+			"azuredevops_git_repository":                        git.DataGitRepository(),
+			"azuredevops_git_repository_tags":                   git.DataGitRepositoryTags(),
+			"azuredevops_git_repository_refs":                   git.DataGitRepositoryRefs(),
+			"azuredevops_git_repository_file":                   git.DataGitRepositoryFile(),
+			"azuredevops_users":                                 graph.DataUsers(),
+			"azuredevops_area":                                  workitemtracking.DataArea(),
+			"azuredevops_iteration":                             workitemtracking.DataIteration(),
+			"azuredevops_team":                                  core.DataTeam(),
+			"azuredevops_teams":                                 core.DataTeams(),
+			"azuredevops_groups":                                graph.DataGroups(),
+			"azuredevops_variable_group":                        taskagent.DataVariableGroup(),
+			"azuredevops_secure_file":                           taskagent.DataSecureFile(),
+			"azuredevops_variable_groups":                       taskagent.DataVariableGroups(),
+			"azuredevops_environment_resources_virtual_machine": taskagent.DataEnvironmentResourcesVirtualMachine(),
+			"azuredevops_deployment_group_targets":              taskagent.DataDeploymentGroupTargets(),
+			"azuredevops_task_group":                            taskagent.DataTaskGroup(),
+			"azuredevops_serviceendpoint_azurerm":               serviceendpoint.DataServiceEndpointAzureRM(),
+			"azuredevops_serviceendpoint_github":                serviceendpoint.DataServiceEndpointGithub(),
 		},
 		Schema: map[string]*schema.Schema{
 			"org_service_url": {
@@ -75,6 +75,8 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_jfrog_xray_v2",
 		"azuredevops_serviceendpoint_externaltfs",
 		"azuredevops_variable_group",
+		"azuredevops_variable_group_variable",
+		"azuredevops_secure_file",
 		"azuredevops_repository_policy_author_email_pattern",
 		"azuredevops_repository_policy_case_enforcement",
 		"azuredevops_repository_policy_file_path_pattern",
@@ -85,10 +87,17 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_branch",
 		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_files",
+		"azuredevops_git_repository_directory",
+		"azuredevops_git_repository_tag",
+		"azuredevops_git_fork_sync",
 		"azuredevops_user_entitlement",
 		"azuredevops_group_membership",
 		"azuredevops_group",
 		"azuredevops_agent_pool",
+		"azuredevops_elastic_pool",
+		"azuredevops_deployment_group",
+		"azuredevops_task_group",
 		"azuredevops_agent_queue",
 		"azuredevops_project_permissions",
 		"azuredevops_git_permissions",
@@ -101,8 +110,15 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_permissions",
 		"azuredevops_servicehook_permissions",
 		"azuredevops_variable_group_permissions",
+		"azuredevops_agent_pool_role_assignments",
+		"azuredevops_agent_queue_role_assignments",
+		"azuredevops_environment_role_assignments",
+		"azuredevops_variable_group_role_assignments",
+		"azuredevops_serviceendpoint_role_assignments",
 		"azuredevops_tagging_permissions",
 		"azuredevops_environment",
+		"azuredevops_environment_resource_kubernetes",
+		"azuredevops_environment_permissions",
 		"azuredevops_build_folder",
 		"azuredevops_build_folder_permissions",
 		"azuredevops_workitem",
@@ -126,16 +142,26 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_projects",
 		"azuredevops_git_repositories",
 		"azuredevops_git_repository",
+		"azuredevops_git_repository_tags",
+		"azuredevops_git_repository_refs",
+		"azuredevops_git_repository_file",
 		"azuredevops_users",
 		"azuredevops_agent_pool",
 		"azuredevops_agent_pools",
 		"azuredevops_agent_queue",
+		"azuredevops_agent_queues",
+		"azuredevops_agents",
 		"azuredevops_area",
 		"azuredevops_iteration",
 		"azuredevops_team",
 		"azuredevops_teams",
 		"azuredevops_groups",
 		"azuredevops_variable_group",
+		"azuredevops_secure_file",
+		"azuredevops_variable_groups",
+		"azuredevops_environment_resources_virtual_machine",
+		"azuredevops_deployment_group_targets",
+		"azuredevops_task_group",
 		"azuredevops_serviceendpoint_azurerm",
 		"azuredevops_serviceendpoint_github",
 	}